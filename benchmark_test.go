@@ -27,3 +27,29 @@ func BenchmarkFieldSuccessParallel(b *testing.B) {
 		}
 	})
 }
+
+func BenchmarkMapSource(b *testing.B) {
+	v := New(M{"name": "inhere", "email": "some@example.com"})
+	v.StringRule("name", "required|minLen:3|alphaNum")
+	v.StringRule("email", "required|email")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		v.ResetResult()
+		_ = v.Validate()
+	}
+}
+
+func BenchmarkStringMapSource(b *testing.B) {
+	v := New(map[string]string{"name": "inhere", "email": "some@example.com"})
+	v.StringRule("name", "required|minLen:3|alphaNum")
+	v.StringRule("email", "required|email")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		v.ResetResult()
+		_ = v.Validate()
+	}
+}
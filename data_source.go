@@ -3,6 +3,10 @@ package validate
 import (
 	"encoding/json"
 	"fmt"
+	"image"
+	_ "image/gif"  // register gif decoder for image.DecodeConfig
+	_ "image/jpeg" // register jpeg decoder for image.DecodeConfig
+	_ "image/png"  // register png decoder for image.DecodeConfig
 	"io"
 	"mime/multipart"
 	"net/http"
@@ -57,6 +61,30 @@ type (
 	UnmarshalFunc func(r *http.Request, data []byte, v interface{}) (int, error)
 )
 
+// SetJSONCodec set custom JSON marshal and unmarshal func, used by BindSafeData and the JSON data source.
+// eg: use jsoniter or sonic for better performance.
+//
+//	validate.SetJSONCodec(sonic.Marshal, sonic.Unmarshal)
+//
+// Pass nil to restore the default one, which based on encoding/json.
+func SetJSONCodec(marshal func(any) ([]byte, error), unmarshal func([]byte, any) error) {
+	if marshal == nil {
+		Marshal = json.Marshal
+	} else {
+		Marshal = marshal
+	}
+
+	if unmarshal == nil {
+		Unmarshal = jsonutil.Unmarshal
+		return
+	}
+
+	// keep the checks of the request and data, only delegate the decoding.
+	Unmarshal = func(r *http.Request, data []byte, ptr any) (int, error) {
+		return jsonutil.UnmarshalWith(unmarshal, r, data, ptr)
+	}
+}
+
 // DataFace data source interface definition
 //
 // Current has three data source:
@@ -161,6 +189,363 @@ func (d *MapData) BindJSON(ptr interface{}) (int, error) {
 	return Unmarshal(nil, d.bodyJSON, ptr)
 }
 
+/*************************************************************
+ * String Map Data
+ *************************************************************/
+
+// StringMapData definition. a map source specialization for map[string]string,
+// the getters return string value directly, dont use reflection.
+//
+// On validate, the "func(s string) bool" validators will be called directly, without reflection.
+type StringMapData struct {
+	// Map the source string map data
+	Map map[string]string
+	// the values boxed as any, avoid allocation on each get. it is read-only on Get.
+	values map[string]any
+}
+
+// box each string value as any, will be reuse on Get.
+func (d *StringMapData) boxValues() {
+	d.values = make(map[string]any, len(d.Map))
+	for k, s := range d.Map {
+		d.values[k] = s
+	}
+}
+
+// Src get
+func (d *StringMapData) Src() any {
+	return d.Map
+}
+
+// Type get
+func (d *StringMapData) Type() uint8 {
+	return sourceMap
+}
+
+// Set value by key. the val will be converted to string.
+func (d *StringMapData) Set(field string, val any) (any, error) {
+	str, ok := val.(string)
+	if !ok {
+		var err error
+		if str, err = strutil.ToString(val); err != nil {
+			return nil, err
+		}
+	}
+
+	d.Map[field] = str
+	if d.values != nil {
+		d.values[field] = str
+	}
+	return str, nil
+}
+
+// Get value by key
+func (d *StringMapData) Get(field string) (any, bool) {
+	str, ok := d.Map[field]
+	if !ok {
+		return nil, false
+	}
+
+	// the Map maybe changed directly, so check the boxed value is same.
+	if val, ok := d.values[field]; ok && val.(string) == str {
+		return val, true
+	}
+	return str, true
+}
+
+// TryGet value by key
+func (d *StringMapData) TryGet(field string) (val any, exist, zero bool) {
+	val, exist = d.Get(field)
+	return
+}
+
+// Create a Validation from data
+func (d *StringMapData) Create(err ...error) *Validation {
+	return d.Validation(err...)
+}
+
+// Validation create from data
+func (d *StringMapData) Validation(err ...error) *Validation {
+	if len(err) > 0 {
+		return NewValidation(d).WithError(err[0])
+	}
+	return NewValidation(d)
+}
+
+/*************************************************************
+ * Raw JSON Data
+ *************************************************************/
+
+// RawJSONData definition. the source from raw JSON bytes, the field can be an
+// RFC 6901 JSON pointer. eg: "/data/attributes/email", "/items/0/id"
+//
+// The field "#" is the whole document, the field not start with "/" will be
+// found by key path. eg: "data.attributes.email"
+type RawJSONData struct {
+	// Raw the original JSON bytes
+	Raw []byte
+	// the decoded JSON value. allow object, array or scalar value
+	root any
+}
+
+// Src get
+func (d *RawJSONData) Src() any {
+	return d.root
+}
+
+// Type get
+func (d *RawJSONData) Type() uint8 {
+	return sourceMap
+}
+
+// Set value by key or JSON pointer. the parent value must exist.
+func (d *RawJSONData) Set(field string, val any) (any, error) {
+	if !isPointerField(field) {
+		mp, ok := d.root.(map[string]any)
+		if !ok {
+			return nil, ErrInvalidData
+		}
+
+		mp[field] = val
+		return val, nil
+	}
+
+	tokens := jsonPointerTokens(field)
+	if len(tokens) == 0 {
+		d.root = val
+		return val, nil
+	}
+
+	last := len(tokens) - 1
+	parent, ok := lookupJSONPointer(d.root, tokens[:last])
+	if !ok {
+		return nil, ErrNoField
+	}
+
+	switch typVal := parent.(type) {
+	case map[string]any:
+		typVal[tokens[last]] = val
+	case []any:
+		idx, ok := jsonPointerIndex(tokens[last], len(typVal))
+		if !ok {
+			return nil, ErrNoField
+		}
+		typVal[idx] = val
+	default:
+		return nil, ErrNoField
+	}
+	return val, nil
+}
+
+// Get value by key or JSON pointer
+func (d *RawJSONData) Get(field string) (any, bool) {
+	if isPointerField(field) {
+		return lookupJSONPointer(d.root, jsonPointerTokens(field))
+	}
+
+	mp, ok := d.root.(map[string]any)
+	if !ok {
+		return nil, false
+	}
+	return maputil.GetByPath(field, mp)
+}
+
+// TryGet value by key or JSON pointer
+func (d *RawJSONData) TryGet(field string) (val any, exist, zero bool) {
+	val, exist = d.Get(field)
+	return
+}
+
+// Create a Validation from data
+func (d *RawJSONData) Create(err ...error) *Validation {
+	return d.Validation(err...)
+}
+
+// Validation create from data
+func (d *RawJSONData) Validation(err ...error) *Validation {
+	if len(err) > 0 {
+		return NewValidation(d).WithError(err[0])
+	}
+	return NewValidation(d)
+}
+
+// check the field is a JSON pointer. "" and "#" is the whole document.
+func isPointerField(field string) bool {
+	return field == "" || field == "#" || field[0] == '/'
+}
+
+// split the JSON pointer to reference tokens. eg: "/a~1b/0" -> ["a/b", "0"]
+func jsonPointerTokens(pointer string) []string {
+	if pointer == "" || pointer == "#" {
+		return nil
+	}
+
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		if strings.ContainsRune(token, '~') {
+			tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		}
+	}
+	return tokens
+}
+
+// parse the array index token. leading zeros and "-" are not allowed.
+func jsonPointerIndex(token string, length int) (int, bool) {
+	if token == "" || (len(token) > 1 && token[0] == '0') {
+		return 0, false
+	}
+
+	idx, err := strconv.Atoi(token)
+	if err != nil || idx < 0 || idx >= length {
+		return 0, false
+	}
+	return idx, true
+}
+
+// find the value by JSON pointer tokens
+func lookupJSONPointer(val any, tokens []string) (any, bool) {
+	for _, token := range tokens {
+		switch typVal := val.(type) {
+		case map[string]any:
+			var ok bool
+			if val, ok = typVal[token]; !ok {
+				return nil, false
+			}
+		case []any:
+			idx, ok := jsonPointerIndex(token, len(typVal))
+			if !ok {
+				return nil, false
+			}
+			val = typVal[idx]
+		default:
+			return nil, false
+		}
+	}
+	return val, true
+}
+
+/*************************************************************
+ * Slice Data
+ *************************************************************/
+
+// SliceData definition. a positional source for the CSV row data,
+// the rule field is the column index. eg: "0", "1"
+//
+// Usage:
+//
+//	v := validate.FromSlice(row).Create()
+//	v.StringRules(validate.MS{"0": "required", "1": "email"})
+type SliceData struct {
+	// Row the source row data
+	Row []string
+}
+
+// Src get
+func (d *SliceData) Src() any {
+	return d.Row
+}
+
+// Type get
+func (d *SliceData) Type() uint8 {
+	return sourceMap
+}
+
+// Set value by index. the val will be converted to string.
+func (d *SliceData) Set(field string, val any) (any, error) {
+	idx, ok := d.index(field)
+	if !ok {
+		return nil, ErrNoField
+	}
+
+	str, err := strutil.ToString(val)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Row[idx] = str
+	return str, nil
+}
+
+// Get value by index
+func (d *SliceData) Get(field string) (any, bool) {
+	idx, ok := d.index(field)
+	if !ok {
+		return nil, false
+	}
+	return d.Row[idx], true
+}
+
+// TryGet value by index
+func (d *SliceData) TryGet(field string) (val any, exist, zero bool) {
+	val, exist = d.Get(field)
+	return
+}
+
+// Create a Validation from data
+func (d *SliceData) Create(err ...error) *Validation {
+	return d.Validation(err...)
+}
+
+// Validation create from data
+func (d *SliceData) Validation(err ...error) *Validation {
+	if len(err) > 0 {
+		return NewValidation(d).WithError(err[0])
+	}
+	return NewValidation(d)
+}
+
+// BindStruct bind the row data to the struct ptr by the field tag "index".
+//
+// Usage:
+//
+//	type User struct {
+//		Name  string `index:"0"`
+//		Email string `index:"1"`
+//		Age   int    `index:"2"`
+//	}
+//	err := validate.FromSlice(row).BindStruct(&user)
+func (d *SliceData) BindStruct(ptr any) error {
+	rv := reflect.ValueOf(ptr)
+	if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Struct {
+		return ErrInvalidData
+	}
+
+	rv = rv.Elem()
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		tag := rt.Field(i).Tag.Get(indexTag)
+		if tag == "" || tag == "-" {
+			continue
+		}
+
+		val, ok := d.Get(tag)
+		if !ok {
+			continue
+		}
+
+		fv := rv.Field(i)
+		if !fv.CanSet() {
+			continue
+		}
+
+		nv, err := reflects.ConvToKind(val, fv.Kind())
+		if err != nil {
+			return fmt.Errorf("bind the index %s to field %s error: %w", tag, rt.Field(i).Name, err)
+		}
+		fv.Set(nv.Convert(fv.Type()))
+	}
+	return nil
+}
+
+// parse the field as row index
+func (d *SliceData) index(field string) (int, bool) {
+	idx, err := strconv.Atoi(field)
+	if err != nil || idx < 0 || idx >= len(d.Row) {
+		return 0, false
+	}
+	return idx, true
+}
+
 /*************************************************************
  * Struct Data
  *************************************************************/
@@ -310,12 +695,57 @@ func (d *StructData) parseRulesFromTag(v *Validation) {
 	}
 
 	fOutMap := make(map[string]string)
-	var recursiveFunc func(vv reflect.Value, vt reflect.Type, preStrName string, parentIsAnonymous bool)
+	var recursiveFunc func(vv reflect.Value, vt reflect.Type, preStrName string, parentIsAnonymous bool, depth int)
+
+	// the pointers on the current recursive path, use for detect the cyclic pointer graph.
+	visiting := make(map[uintptr]bool)
+	var depthExceeded bool
+
+	// add the output name for array/map element. eg: "Items.0" -> "items.0"
+	// then the output name of the element sub fields can be resolved. eg: "items.0.name"
+	addElemOutName := func(parentName, elemName string) {
+		if pOutName, ok := fOutMap[parentName]; ok {
+			fOutMap[elemName] = pOutName + elemName[len(parentName):]
+		}
+	}
+
+	// collect rules from the sub struct value. skip the cyclic pointer and check the max depth.
+	collectSub := func(raw, elemValue reflect.Value, elemType reflect.Type, name string, anonymous bool, depth int) {
+		// the optional sub struct is absent, skip the rules of the sub fields.
+		// the rules of the pointer field itself is still checked. eg: `validate:"required"`
+		isPtr := raw.Kind() == reflect.Pointer
+		if isPtr && raw.IsNil() {
+			return
+		}
+
+		if depth > gOpt.MaxDepth && gOpt.MaxDepth > 0 {
+			if !depthExceeded {
+				depthExceeded = true
+				v.AddErrorf(validateError, "the struct nesting depth exceeds the max depth %d at field '%s'", gOpt.MaxDepth, name)
+			}
+			return
+		}
+
+		if isPtr {
+			ptr := raw.Pointer()
+			if visiting[ptr] {
+				return
+			}
+
+			visiting[ptr] = true
+			defer delete(visiting, ptr)
+		}
+		recursiveFunc(elemValue, elemType, name, anonymous, depth)
+	}
 
 	vv := d.value
 	vt := d.valueTyp
+	if vv.Kind() == reflect.Pointer && !vv.IsNil() {
+		visiting[vv.Pointer()] = true
+	}
+
 	// preStrName - the parent field name.
-	recursiveFunc = func(vv reflect.Value, vt reflect.Type, parentFName string, parentIsAnonymous bool) {
+	recursiveFunc = func(vv reflect.Value, vt reflect.Type, parentFName string, parentIsAnonymous bool, depth int) {
 		for i := 0; i < vt.NumField(); i++ {
 			fv := vt.Field(i)
 			// skip don't exported field
@@ -393,11 +823,12 @@ func (d *StructData) parseRulesFromTag(v *Validation) {
 					continue
 				}
 
-				fValue := removeValuePtr(vv).Field(i)
+				rawValue := removeValuePtr(vv).Field(i)
+				fValue := rawValue
 
 				switch ft.Kind() {
 				case reflect.Struct:
-					recursiveFunc(fValue, ft, name, fv.Anonymous)
+					collectSub(rawValue, fValue, ft, name, fv.Anonymous, depth+1)
 
 				case reflect.Array, reflect.Slice:
 					fValue = removeValuePtr(fValue)
@@ -408,12 +839,14 @@ func (d *StructData) parseRulesFromTag(v *Validation) {
 					}
 
 					for j := 0; j < fValue.Len(); j++ {
-						elemValue := removeValuePtr(fValue.Index(j))
+						rawElem := fValue.Index(j)
+						elemValue := removeValuePtr(rawElem)
 						elemType := removeTypePtr(elemValue.Type())
 
 						arrayName := fmt.Sprintf("%s.%d", name, j)
 						if elemType.Kind() == reflect.Struct {
-							recursiveFunc(elemValue, elemType, arrayName, fv.Anonymous)
+							addElemOutName(name, arrayName)
+							collectSub(rawElem, elemValue, elemType, arrayName, fv.Anonymous, depth+1)
 						}
 					}
 
@@ -421,7 +854,8 @@ func (d *StructData) parseRulesFromTag(v *Validation) {
 					fValue = removeValuePtr(fValue)
 					for _, key := range fValue.MapKeys() {
 						key = removeValuePtr(key)
-						elemValue := removeValuePtr(fValue.MapIndex(key))
+						rawElem := fValue.MapIndex(key)
+						elemValue := removeValuePtr(rawElem)
 						elemType := removeTypePtr(elemValue.Type())
 
 						format := "%s."
@@ -441,7 +875,8 @@ func (d *StructData) parseRulesFromTag(v *Validation) {
 
 						arrayName := fmt.Sprintf(format, name, val)
 						if elemType.Kind() == reflect.Struct {
-							recursiveFunc(elemValue, elemType, arrayName, fv.Anonymous)
+							addElemOutName(name, arrayName)
+							collectSub(rawElem, elemValue, elemType, arrayName, fv.Anonymous, depth+1)
 						}
 					}
 				default:
@@ -451,7 +886,7 @@ func (d *StructData) parseRulesFromTag(v *Validation) {
 		}
 	}
 
-	recursiveFunc(removeValuePtr(vv), vt, "", false)
+	recursiveFunc(removeValuePtr(vv), vt, "", false, 0)
 
 	if len(fOutMap) > 0 {
 		v.Trans().AddFieldMap(fOutMap)
@@ -713,6 +1148,40 @@ func (d *StructData) FuncValue(name string) (reflect.Value, bool) {
 	return fv, fv.IsValid()
 }
 
+// get the kind of the field type, will remove the pointer. return reflect.Invalid on field not found.
+func (d *StructData) fieldKind(field string) reflect.Kind {
+	field = strutil.UpperFirst(field)
+	if sf, ok := d.valueTyp.FieldByName(field); ok {
+		return removeTypePtr(sf.Type).Kind()
+	}
+
+	if _, exist, _ := d.TryGet(field); exist {
+		return removeTypePtr(d.fieldValues[field].Type()).Kind()
+	}
+	return reflect.Invalid
+}
+
+// get the type of the field by the field path. eg: "Home.City" "Items.0.Name"
+func (d *StructData) fieldType(field string) (reflect.Type, bool) {
+	typ := d.valueTyp
+	for _, node := range strings.Split(strutil.UpperFirst(field), ".") {
+		typ = removeTypePtr(typ)
+		switch typ.Kind() {
+		case reflect.Struct:
+			sf, ok := typ.FieldByName(node)
+			if !ok {
+				return nil, false
+			}
+			typ = sf.Type
+		case reflect.Array, reflect.Slice, reflect.Map:
+			typ = typ.Elem()
+		default:
+			return nil, false
+		}
+	}
+	return typ, true
+}
+
 // HasField in the src struct
 func (d *StructData) HasField(field string) bool {
 	if _, ok := d.fieldNames[field]; ok {
@@ -963,6 +1432,24 @@ func (d FormData) FileBytes(field string) ([]byte, error) {
 	return io.ReadAll(file)
 }
 
+// FileImageConfig decode the image config(width, height) of the uploaded file.
+// only decode the image header, does not decode the entire image.
+func (d FormData) FileImageConfig(field string) (cfg image.Config, err error) {
+	fh, found := d.Files[field]
+	if !found {
+		return cfg, ErrNoField
+	}
+
+	file, err := fh.Open()
+	if err != nil {
+		return cfg, err
+	}
+	defer file.Close()
+
+	cfg, _, err = image.DecodeConfig(file)
+	return
+}
+
 // FileMimeType get File Mime Type name. eg "image/png"
 func (d FormData) FileMimeType(field string) (mime string) {
 	fh, found := d.Files[field]
@@ -1,8 +1,11 @@
 package validate
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"mime/multipart"
+	"net/http"
 	"net/url"
 	"reflect"
 	"testing"
@@ -10,6 +13,7 @@ import (
 
 	"github.com/gookit/goutil/dump"
 	"github.com/gookit/goutil/testutil/assert"
+	"github.com/guptaaashutosh/go_validate/jsonutil"
 )
 
 func TestData(t *testing.T) {
@@ -50,6 +54,346 @@ func TestData(t *testing.T) {
 	is.Error(err)
 }
 
+func TestStringMapData(t *testing.T) {
+	is := assert.New(t)
+
+	d := FromStringMap(map[string]string{"name": "inhere", "age": "23"})
+	is.Equal(sourceMap, d.Type())
+	is.NotEmpty(d.Src())
+
+	val, ok := d.Get("name")
+	is.True(ok)
+	is.Equal("inhere", val)
+	_, ok = d.Get("not-exist")
+	is.False(ok)
+
+	nv, err := d.Set("age", 24)
+	is.NoErr(err)
+	is.Equal("24", nv)
+	is.Equal("24", d.Map["age"])
+	_, err = d.Set("age", []int{1})
+	is.Err(err)
+
+	v := d.Create()
+	v.StringRule("name", "required|minLen:3")
+	v.StringRule("age", "required|isNumber|min:18")
+	is.True(v.Validate())
+	is.Equal("inhere", v.SafeVal("name"))
+
+	v = New(map[string]string{"name": "in"})
+	v.StringRule("name", "required|minLen:3")
+	is.False(v.Validate())
+	is.True(v.Errors.HasField("name"))
+
+	// the "func(s string) bool" validators use the fast path
+	v = New(map[string]string{"name": "in-here", "email": "some@example.com"})
+	v.StopOnError = false
+	v.StringRule("name", "required|alphaNum")
+	v.StringRule("email", "required|email")
+	is.False(v.Validate())
+	is.True(v.Errors.HasField("name"))
+	is.False(v.Errors.HasField("email"))
+
+	// the Map is changed directly
+	d.Map["name"] = "new-name"
+	val, _ = d.Get("name")
+	is.Equal("new-name", val)
+
+	is.NotNil(FromStringMap(nil).Map)
+}
+
+func TestFromValues(t *testing.T) {
+	is := assert.New(t)
+
+	// eg: collected by chi.URLParam()
+	params := map[string]string{"id": "23", "slug": "hello-world"}
+	v := FromValues(params)
+	v.StringRule("id", "required|isUint|min:1")
+	v.StringRule("slug", "required|minLen:3")
+	is.True(v.Validate())
+	is.Equal("hello-world", v.SafeVal("slug"))
+
+	v = FromValues(map[string]string{"id": "abc"})
+	v.StringRule("id", "required|isUint")
+	is.False(v.Validate())
+	is.True(v.Errors.HasField("id"))
+
+	v = FromValues(nil)
+	v.StringRule("id", "required")
+	is.False(v.Validate())
+}
+
+func TestStringMapData_envKeys(t *testing.T) {
+	is := assert.New(t)
+
+	env := map[string]string{
+		"DATABASE_URL": "not-a-url",
+		"APP_PORT":     "8080",
+		"LOG_LEVEL":    "",
+	}
+	v := New(env)
+	v.StopOnError = false
+	v.StringRules(MS{
+		"DATABASE_URL": "required|fullUrl",
+		"APP_PORT":     "required|int|range:1,65535",
+		"LOG_LEVEL":    "required",
+		"_SECRET_KEY":  "required",
+	})
+	is.False(v.Validate())
+	is.True(v.Errors.HasField("DATABASE_URL"))
+	is.True(v.Errors.HasField("LOG_LEVEL"))
+	is.True(v.Errors.HasField("_SECRET_KEY"))
+	is.False(v.Errors.HasField("APP_PORT"))
+	is.Equal([]string{"APP_PORT"}, v.PassedFields())
+	is.Contains(v.Errors.FieldOne("LOG_LEVEL"), "LOG_LEVEL")
+
+	// load env style rules
+	rules, err := LoadRules([]byte("DATABASE_URL: required|fullUrl\nAPP_PORT: required|int|min:1"), "yaml")
+	is.NoErr(err)
+	is.Equal(MS{"DATABASE_URL": "required|fullUrl", "APP_PORT": "required|int|min:1"}, rules)
+	v = New(map[string]string{"DATABASE_URL": "https://db.example.com", "APP_PORT": "8080"})
+	v.StringRules(rules)
+	is.True(v.Validate())
+	is.Equal("8080", v.SafeVal("APP_PORT"))
+}
+
+func TestUnmarshal_trailingData(t *testing.T) {
+	is := assert.New(t)
+	mp := map[string]any{}
+
+	code, err := Unmarshal(nil, []byte(`{"a": 1}{"b": 2}`), &mp)
+	is.Equal(400, code)
+	is.ErrMsg(err, "body must contain only one JSON object, found a second JSON object at position 8")
+
+	code, err = Unmarshal(nil, []byte("{\"a\": 1} \n [1]"), &mp)
+	is.Equal(400, code)
+	is.ErrMsg(err, "body must contain only one JSON object, found a second JSON value at position 11")
+
+	_, err = Unmarshal(nil, []byte(`{"a": 1} abc`), &mp)
+	is.ErrMsg(err, "body must contain only one JSON object, found unexpected trailing data at position 9")
+
+	// trailing whitespace is allowed
+	code, err = Unmarshal(nil, []byte("{\"a\": 1} \n\t"), &mp)
+	is.NoErr(err)
+	is.Equal(200, code)
+}
+
+func TestHasAnyContentType(t *testing.T) {
+	is := assert.New(t)
+	newReq := func(contentType string) *http.Request {
+		r, _ := http.NewRequest(http.MethodPost, "/", bytes.NewBufferString(`{"name": "inhere"}`))
+		if contentType != "" {
+			r.Header.Set("Content-Type", contentType)
+		}
+		return r
+	}
+
+	is.True(jsonutil.HasAnyContentType(newReq("application/vnd.api+json"), "application/json", "+json"))
+	is.True(jsonutil.HasAnyContentType(newReq("application/json; charset=utf-8"), "application/json"))
+	is.True(jsonutil.HasAnyContentType(newReq("application/vnd.api+json; charset=utf-8"), "+json"))
+	is.True(jsonutil.HasAnyContentType(newReq(""), "application/octet-stream"))
+	is.False(jsonutil.HasAnyContentType(newReq("text/plain"), "application/json", "+json"))
+	is.False(jsonutil.HasAnyContentType(newReq("application/vnd.api+xml"), "+json"))
+	is.True(jsonutil.HasContentType(newReq("application/json"), "application/json"))
+
+	// unmarshal the vendor type body
+	mp := map[string]any{}
+	code, err := Unmarshal(newReq("application/vnd.api+json; charset=utf-8"), nil, &mp)
+	is.NoErr(err)
+	is.Equal(200, code)
+	is.Equal("inhere", mp["name"])
+}
+
+func TestSetJSONCodec(t *testing.T) {
+	is := assert.New(t)
+	defer SetJSONCodec(nil, nil)
+
+	var marshalCalls, unmarshalCalls int
+	SetJSONCodec(func(v any) ([]byte, error) {
+		marshalCalls++
+		return json.Marshal(v)
+	}, func(bs []byte, ptr any) error {
+		unmarshalCalls++
+		return json.Unmarshal(bs, ptr)
+	})
+
+	v := Map(M{"name": " inhere ", "age": 23})
+	v.StringRule("name", "required", "trim")
+	v.StringRule("age", "int")
+	is.True(v.Validate())
+
+	u := &struct {
+		Name string
+		Age  int
+	}{}
+	code, err := v.BindSafeData(u)
+	is.NoErr(err)
+	is.Equal(200, code)
+	is.Equal("inhere", u.Name)
+	is.Equal(23, u.Age)
+	is.Equal(1, marshalCalls)
+	is.Equal(1, unmarshalCalls)
+
+	// the body of request is read by the custom codec
+	r, _ := http.NewRequest(http.MethodPost, "/", bytes.NewBufferString(`{"name": "inhere"}`))
+	r.Header.Set("Content-Type", "application/json")
+	mp := map[string]any{}
+	_, err = Unmarshal(r, nil, &mp)
+	is.NoErr(err)
+	is.Equal("inhere", mp["name"])
+	is.Equal(2, unmarshalCalls)
+
+	// the checks are kept with the custom codec
+	r, _ = http.NewRequest(http.MethodPost, "/", bytes.NewBufferString(`{"name": "inhere"}`))
+	r.Header.Set("Content-Type", "text/plain")
+	code, err = Unmarshal(r, nil, &mp)
+	is.ErrMsg(err, "content-type is not application/json")
+	is.Equal(http.StatusUnsupportedMediaType, code)
+	_, err = Unmarshal(nil, nil, &mp)
+	is.ErrMsg(err, "no data provided")
+	_, err = Unmarshal(r, []byte(`{}`), &mp)
+	is.ErrMsg(err, "multiple data provided for unmarshalling not supported")
+	_, err = Unmarshal(nil, []byte(""), &mp)
+	is.ErrMsg(err, "body must not be empty")
+	_, err = Unmarshal(nil, []byte(`{"a": 1} {"b": 2}`), &mp)
+	is.ErrMsg(err, "body must contain only one JSON object, found a second JSON object at position 9")
+	is.Equal(2, unmarshalCalls)
+
+	// the raw JSON data source is decoded by the custom codec
+	_, err = FromRawJSON([]byte(`{"name": "inhere"}`))
+	is.NoErr(err)
+	is.Equal(3, unmarshalCalls)
+
+	// restore the default codec
+	SetJSONCodec(nil, nil)
+	_, err = v.BindSafeData(u)
+	is.NoErr(err)
+	is.Equal(1, marshalCalls)
+	is.Equal(3, unmarshalCalls)
+}
+
+func TestRawJSONData(t *testing.T) {
+	is := assert.New(t)
+
+	body := `{"data": {"attributes": {"email": "some@example.com", "a/b": 1}, "items": [{"id": 23}]}}`
+	d, err := FromRawJSON([]byte(body))
+	is.NoErr(err)
+	is.Equal(sourceMap, d.Type())
+
+	val, ok := d.Get("/data/attributes/email")
+	is.True(ok)
+	is.Equal("some@example.com", val)
+	val, ok = d.Get("/data/attributes/a~1b")
+	is.True(ok)
+	is.Equal(float64(1), val)
+	val, ok = d.Get("/data/items/0/id")
+	is.True(ok)
+	is.Equal(float64(23), val)
+	val, ok = d.Get("data.attributes.email")
+	is.True(ok)
+	is.Equal("some@example.com", val)
+
+	// missing pointers
+	_, ok = d.Get("/data/attributes/name")
+	is.False(ok)
+	_, ok = d.Get("/data/items/1/id")
+	is.False(ok)
+	_, ok = d.Get("/data/items/00/id")
+	is.False(ok)
+
+	_, err = d.Set("/data/attributes/name", "inhere")
+	is.NoErr(err)
+	val, _ = d.Get("/data/attributes/name")
+	is.Equal("inhere", val)
+	_, err = d.Set("/not/exist", "val")
+	is.Err(err)
+
+	v := d.Create()
+	v.StringRule("/data/attributes/email", "required|email")
+	v.StringRule("/data/items/0/id", "required|int")
+	is.True(v.Validate())
+
+	d, err = FromRawJSON([]byte(`{"data": {"attributes": {"email": "invalid"}}}`))
+	is.NoErr(err)
+	v = d.Create()
+	v.StringRule("/data/attributes/email", "required|email")
+	is.False(v.Validate())
+	is.True(v.Errors.HasField("/data/attributes/email"))
+
+	_, err = FromRawJSON([]byte(`{invalid`))
+	is.Err(err)
+}
+
+func TestRawJSONData_requiredKeys(t *testing.T) {
+	is := assert.New(t)
+
+	d, err := FromRawJSON([]byte(`{"id": 1, "type": null, "data": {"name": "inhere"}}`))
+	is.NoErr(err)
+	v := d.Create()
+	v.StringRule("#", "requiredKeys:id,type")
+	v.StringRule("/data", "required_keys:name")
+	is.True(v.Validate())
+
+	// missing keys
+	v = d.Create()
+	v.StringRule("/data", "requiredKeys:name,age,city")
+	is.False(v.Validate())
+	is.Equal("/data is missing the required keys: age, city", v.Errors.One())
+
+	v = d.Create()
+	v.StringRule("#", "requiredKeys:id,meta")
+	is.False(v.Validate())
+	is.Equal("# is missing the required keys: meta", v.Errors.One())
+
+	// not an object
+	v = d.Create()
+	v.StringRule("/id", "requiredKeys:name")
+	is.False(v.Validate())
+	v = d.Create()
+	v.StringRule("/not-exist", "requiredKeys:name")
+	is.False(v.Validate())
+}
+
+func TestSliceData(t *testing.T) {
+	is := assert.New(t)
+
+	row := []string{"inhere", "some@example.com", "23"}
+	d := FromSlice(row)
+	val, ok := d.Get("1")
+	is.True(ok)
+	is.Equal("some@example.com", val)
+	_, ok = d.Get("3")
+	is.False(ok)
+	_, ok = d.Get("name")
+	is.False(ok)
+
+	v := d.Create()
+	v.StringRules(MS{"0": "required", "1": "email", "2": "int|min:18"})
+	is.True(v.Validate())
+
+	v = FromSlice([]string{"", "invalid", "23"}).Create()
+	v.StringRules(MS{"0": "required", "1": "email"})
+	v.StopOnError = false
+	is.False(v.Validate())
+	is.True(v.Errors.HasField("0"))
+	is.True(v.Errors.HasField("1"))
+
+	// bind by index tag
+	type user struct {
+		Name  string `index:"0"`
+		Email string `index:"1"`
+		Age   int    `index:"2"`
+		Other string
+	}
+	u := &user{}
+	is.NoErr(d.BindStruct(u))
+	is.Equal("inhere", u.Name)
+	is.Equal("some@example.com", u.Email)
+	is.Equal(23, u.Age)
+	is.Err(d.BindStruct(*u))
+	is.Err(FromSlice([]string{"a", "b", "abc"}).BindStruct(u))
+}
+
 func TestFormData(t *testing.T) {
 	is := assert.New(t)
 	d := FromURLValues(url.Values{
@@ -237,6 +581,110 @@ func TestStructData_Get_ptrVal(t *testing.T) {
 	assert.Equal(t, 0, *val.(*int))
 }
 
+type cyclicNode struct {
+	Name string `validate:"required"`
+	Next *cyclicNode
+}
+
+func TestStructData_cyclicAndMaxDepth(t *testing.T) {
+	is := assert.New(t)
+
+	// self-referential struct
+	n := &cyclicNode{Name: "root"}
+	n.Next = n
+	v := Struct(n)
+	is.True(v.Validate())
+	is.Empty(v.Errors)
+
+	// a -> b -> a
+	b := &cyclicNode{}
+	a := &cyclicNode{Name: "a", Next: b}
+	b.Next = a
+	v = Struct(a)
+	is.False(v.Validate())
+	is.True(v.Errors.HasField("Next.Name"))
+
+	// the nesting depth exceeds the max depth
+	Config(func(opt *GlobalOption) {
+		opt.MaxDepth = 3
+	})
+	defer ResetOption()
+
+	head := &cyclicNode{Name: "n0"}
+	for i, cur := 1, head; i < 6; i++ {
+		cur.Next = &cyclicNode{Name: fmt.Sprint("n", i)}
+		cur = cur.Next
+	}
+	v = Struct(head)
+	is.False(v.Validate())
+	is.Equal("the struct nesting depth exceeds the max depth 3 at field 'Next.Next.Next.Next'", v.Errors.FieldOne("_validate"))
+}
+
+func TestStructData_nilOptionalSubStruct(t *testing.T) {
+	is := assert.New(t)
+
+	type address struct {
+		City string `validate:"required"`
+		Zip  string `validate:"minLen:3"`
+	}
+	type user struct {
+		Name    string `validate:"required"`
+		Address *address
+		Home    *address `validate:"required"`
+	}
+
+	// the optional Address is nil, the sub rules are skipped
+	v := Struct(&user{Name: "tom", Home: &address{City: "Berlin"}})
+	is.True(v.Validate())
+	is.Empty(v.Errors)
+
+	// the required pointer field is still checked, but not its sub fields
+	v = Struct(&user{Name: "tom"})
+	v.StopOnError = false
+	is.False(v.Validate())
+	is.True(v.Errors.HasField("Home"))
+	is.False(v.Errors.HasField("Home.City"))
+	is.False(v.Errors.HasField("Address.City"))
+
+	// present sub struct is validated
+	v = Struct(&user{Name: "tom", Address: &address{Zip: "1"}, Home: &address{City: "Berlin"}})
+	v.StopOnError = false
+	is.False(v.Validate())
+	is.True(v.Errors.HasField("Address.City"))
+	is.True(v.Errors.HasField("Address.Zip"))
+}
+
+func TestStructData_nestedLabels(t *testing.T) {
+	is := assert.New(t)
+
+	type address struct {
+		City string `json:"city" label:"City Name" validate:"required"`
+		Zip  string `json:"zip" validate:"required"`
+	}
+	type user struct {
+		Name  string             `json:"name" validate:"required"`
+		Home  address            `json:"home"`
+		Homes []address          `json:"homes"`
+		Extra map[string]address `json:"extra"`
+	}
+
+	v := Struct(&user{
+		Name:  "tom",
+		Homes: []address{{City: "Berlin"}},
+		Extra: map[string]address{"work": {Zip: "10115"}},
+	})
+	v.StopOnError = false
+	is.False(v.Validate())
+
+	// nested field use its label, or the output name with the parent output name
+	is.Equal("City Name is required to not be empty", v.Errors.FieldOne("home.city"))
+	is.Equal("home.zip is required to not be empty", v.Errors.FieldOne("home.zip"))
+	is.Equal("homes.0.zip is required to not be empty", v.Errors.FieldOne("homes.0.zip"))
+	is.Equal("City Name is required to not be empty", v.Errors.FieldOne("extra.work.city"))
+	is.False(v.Errors.HasField("zip"))
+	is.False(v.Errors.HasField("city"))
+}
+
 func TestValidatePrivateFieldsWhenTrue(t *testing.T) {
 	type foo struct {
 		Field1 int `validate:"required|min:5|max:20" message:"Field1 outside of range"`
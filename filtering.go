@@ -1,10 +1,20 @@
 package validate
 
 import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
 	"reflect"
+	"sort"
+	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/gookit/filter"
+	"github.com/gookit/goutil/strutil"
 )
 
 /*************************************************************
@@ -13,6 +23,11 @@ import (
 
 var (
 	filterValues map[string]reflect.Value
+	// built-in filters of the package, extends the "gookit/filter" filters.
+	builtinFilters = map[string]func(val any, args []string) (any, error){
+		"truncate": truncateFilter,
+		"hash":     hashFilter,
+	}
 )
 
 // AddFilters add global filters
@@ -107,6 +122,9 @@ type FilterRule struct {
 	filters []string
 	// filter args. { index: "args" }
 	filterArgs map[int]string
+	// condition for apply the filters. see "filter_if"
+	condField  string
+	condValues []string
 }
 
 func newFilterRule(fields []string) *FilterRule {
@@ -122,11 +140,18 @@ func newFilterRule(fields []string) *FilterRule {
 // Usage:
 //
 //	r.AddFilters("int", "str2arr:,")
+//	// only apply filters when the field "type" value is "email"
+//	r.AddFilters("filter_if:type,email", "lower")
 func (r *FilterRule) AddFilters(filters ...string) *FilterRule {
 	for _, filterName := range filters {
 		pos := strings.IndexRune(filterName, ':')
 		if pos > 0 { // has filter args
 			name := filterName[:pos]
+			if name == "filter_if" || name == "filterIf" {
+				r.SetCondition(parseArgString(filterName[pos+1:])...)
+				continue
+			}
+
 			index := len(r.filters)
 			r.filters = append(r.filters, name)
 			r.filterArgs[index] = filterName[pos+1:]
@@ -138,61 +163,120 @@ func (r *FilterRule) AddFilters(filters ...string) *FilterRule {
 	return r
 }
 
+// SetCondition set the condition for apply the filters.
+// the filters only apply when the field value is in the values.
+//
+// Usage:
+//
+//	r.SetCondition("type", "email", "mail")
+func (r *FilterRule) SetCondition(fieldAndValues ...string) *FilterRule {
+	if len(fieldAndValues) < 2 {
+		panicf("filter_if: must provide the field and at least one value")
+	}
+
+	r.condField = fieldAndValues[0]
+	r.condValues = fieldAndValues[1:]
+	return r
+}
+
+// check the condition is matched
+func (r *FilterRule) condMatched(v *Validation) bool {
+	if r.condField == "" {
+		return true
+	}
+
+	val, exist, _ := v.tryGet(r.condField)
+	if !exist {
+		return false
+	}
+
+	str := strutil.QuietString(val)
+	for _, want := range r.condValues {
+		if str == want {
+			return true
+		}
+	}
+	return false
+}
+
 // Apply rule for the rule fields
 func (r *FilterRule) Apply(v *Validation) (err error) {
+	// the condition is not matched, skip the filters
+	if !r.condMatched(v) {
+		return
+	}
+
 	// filter field value
 	for _, field := range r.Fields() {
-		val, exist, zero := v.tryGet(field)
-		if !exist || zero {
-			defVal, ok := v.GetDefValue(field)
-			// there is also no custom default value
-			if !ok {
-				continue
-			}
-
-			// update source data field value
-			newVal, err := v.updateValue(field, defVal)
-			if err != nil {
+		if err = r.applyField(v, field); err != nil {
+			if !v.CollectFilterErrors {
 				return err
 			}
 
-			// re-set value
-			val = newVal
-
-			// dont need check default value
-			if !v.CheckDefault {
-				v.SaferData[field] = newVal // save validated value.
-				continue
-			}
+			// record the error and continue filtering other fields
+			v.AddError(field, filterError, err.Error())
+			err = nil
 		}
+	}
+	return
+}
 
-		// call filters
-		for i, name := range r.filters {
-			fv := v.FilterFuncValue(name)
-			args := parseArgString(r.filterArgs[i])
-			if !fv.IsValid() { // is built int filters
-				val, err = filter.Apply(name, val, args)
-			} else {
-				val, err = callCustomFilter(fv, val, args)
-			}
-			if err != nil {
-				return err
-			}
+// apply filters to the field value
+func (r *FilterRule) applyField(v *Validation, field string) (err error) {
+	val, exist, zero := v.tryGet(field)
+	if !exist || zero {
+		defVal, ok := v.GetDefValue(field)
+		// there is also no custom default value
+		if !ok {
+			return
 		}
 
 		// update source data field value
-		newVal, err := v.updateValue(field, val)
+		newVal, err := v.updateValue(field, defVal)
 		if err != nil {
 			return err
 		}
-		// Customization: We need to overwrite original field value with filtered/converted value because filtered/converted value was not being loaded in struct while calling BindSafeData().
-		if v.SaferData[field] != "" {
-			// save filtered value.
-			v.SaferData[field] = newVal
+
+		// re-set value
+		val = newVal
+
+		// dont need check default value
+		if !v.CheckDefault {
+			v.SaferData[field] = newVal // save validated value.
+			return nil
+		}
+	}
+
+	// call filters
+	for i, name := range r.filters {
+		fv := v.FilterFuncValue(name)
+		args := parseArgString(r.filterArgs[i])
+		if !fv.IsValid() { // is built int filters
+			if bf, ok := builtinFilters[name]; ok {
+				val, err = bf(val, args)
+			} else {
+				val, err = filter.Apply(name, val, args)
+			}
+		} else {
+			val, err = callCustomFilter(fv, val, args)
+		}
+		if err != nil {
+			return err
 		}
+	}
+
+	// update source data field value
+	newVal, err := v.updateValue(field, val)
+	if err != nil {
+		return err
+	}
+	// Customization: We need to overwrite original field value with filtered/converted value because filtered/converted value was not being loaded in struct while calling BindSafeData().
+	if v.SaferData[field] != "" {
 		// save filtered value.
-		v.filteredData[field] = newVal
+		v.SaferData[field] = newVal
 	}
+	// save filtered value.
+	v.filteredData[field] = newVal
 	return
 }
 
@@ -201,6 +285,55 @@ func (r *FilterRule) Fields() []string {
 	return r.fields
 }
 
+// get the top level fields of the data, which value is string. sorted by name.
+func (v *Validation) stringFields() []string {
+	var names []string
+	switch d := v.data.(type) {
+	case *MapData:
+		for name := range d.Map {
+			names = append(names, name)
+		}
+	case *StringMapData:
+		for name := range d.Map {
+			names = append(names, name)
+		}
+	case *RawJSONData:
+		// only the top level object keys
+		if mp, ok := d.root.(map[string]any); ok {
+			for name := range mp {
+				names = append(names, name)
+			}
+		}
+	case *SliceData:
+		for i := range d.Row {
+			names = append(names, strconv.Itoa(i))
+		}
+	case *FormData:
+		for name := range d.Form {
+			names = append(names, name)
+		}
+	case *StructData:
+		for name, at := range d.fieldNames {
+			// skip sub struct and private fields
+			if at == fieldAtTopStruct && name[0] >= 'A' && name[0] <= 'Z' {
+				names = append(names, name)
+			}
+		}
+	}
+
+	var fields []string
+	for _, name := range names {
+		if val, _ := v.Get(name); val != nil {
+			if _, ok := val.(string); ok {
+				fields = append(fields, name)
+			}
+		}
+	}
+
+	sort.Strings(fields)
+	return fields
+}
+
 func callCustomFilter(fv reflect.Value, val any, args []string) (any, error) {
 	var rs []reflect.Value
 	if len(args) > 0 {
@@ -224,3 +357,74 @@ func callCustomFilter(fv reflect.Value, val any, args []string) (any, error) {
 
 	return val, nil
 }
+
+// Truncate the string to max n runes(not bytes), will append the
+// suffix(eg: "...") on the string has been truncated.
+//
+// Usage:
+//
+//	Truncate("hello world", 5) // "hello"
+//	Truncate("hello world", 5, "...") // "hello..."
+func Truncate(s string, n int, suffix ...string) string {
+	if n < 0 || utf8.RuneCountInString(s) <= n {
+		return s
+	}
+
+	s = string([]rune(s)[:n])
+	if len(suffix) > 0 {
+		s += suffix[0]
+	}
+	return s
+}
+
+// filter "truncate:100" or "truncate:100,..."
+func truncateFilter(val any, args []string) (any, error) {
+	if len(args) == 0 {
+		return nil, errors.New("truncate: missing the max length argument")
+	}
+
+	n, err := strconv.Atoi(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("truncate: invalid max length %q", args[0])
+	}
+
+	str, err := strutil.ToString(val)
+	if err != nil {
+		return nil, err
+	}
+	return Truncate(str, n, args[1:]...), nil
+}
+
+// Hash the string by the algo, returns the hex digest. algo allow: sha256, sha1, md5
+//
+// Usage:
+//
+//	Hash("hello", "sha256") // "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+func Hash(s, algo string) (string, error) {
+	switch strings.ToLower(algo) {
+	case "sha256":
+		sum := sha256.Sum256([]byte(s))
+		return hex.EncodeToString(sum[:]), nil
+	case "sha1":
+		sum := sha1.Sum([]byte(s)) //nolint:gosec
+		return hex.EncodeToString(sum[:]), nil
+	case "md5":
+		sum := md5.Sum([]byte(s)) //nolint:gosec
+		return hex.EncodeToString(sum[:]), nil
+	}
+	return "", fmt.Errorf("hash: unsupported algo %q", algo)
+}
+
+// filter "hash:sha256", default algo is sha256
+func hashFilter(val any, args []string) (any, error) {
+	algo := "sha256"
+	if len(args) > 0 {
+		algo = args[0]
+	}
+
+	str, err := strutil.ToString(val)
+	if err != nil {
+		return nil, err
+	}
+	return Hash(str, algo)
+}
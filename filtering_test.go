@@ -141,3 +141,157 @@ func TestFilterRuleNilValue(t *testing.T) {
 		v.Validate()
 	})
 }
+
+func TestFilter_truncate(t *testing.T) {
+	is := assert.New(t)
+
+	is.Equal("hello", Truncate("hello", 5))
+	is.Equal("hello", Truncate("hello world", 5))
+	is.Equal("hello...", Truncate("hello world", 5, "..."))
+	is.Equal("你好", Truncate("你好世界", 2))
+	is.Equal("你好世界", Truncate("你好世界", 4, "..."))
+	is.Equal("你好世…", Truncate("你好世界", 3, "…"))
+	is.Equal("", Truncate("你好", 0))
+
+	v := New(M{
+		"title": "你好世界, hello",
+		"desc":  "short",
+		"name":  "héllo wörld",
+	})
+	v.FilterRules(MS{
+		"title": "truncate:4",
+		"desc":  "truncate:10,...",
+		"name":  "truncate:4,...",
+	})
+	is.True(v.Validate())
+	is.Equal("你好世界", v.FilteredData()["title"])
+	is.Equal("short", v.FilteredData()["desc"])
+	is.Equal("héll...", v.FilteredData()["name"])
+
+	// invalid args
+	v = New(M{"title": "hello"})
+	v.FilterRule("title", "truncate:abc")
+	is.False(v.Validate())
+	is.Contains(v.Errors.One(), "truncate: invalid max length")
+}
+
+func TestFilter_filterIf(t *testing.T) {
+	is := assert.New(t)
+
+	v := New(M{"type": "email", "contact": " Inhere@Example.COM "})
+	v.FilterRule("contact", "filter_if:type,email,mail|trim|lower")
+	is.True(v.Validate())
+	is.Equal("inhere@example.com", v.FilteredData()["contact"])
+
+	// condition is false, skip the filters
+	v = New(M{"type": "phone", "contact": " Inhere "})
+	v.FilterRule("contact", "filter_if:type,email,mail|trim|lower")
+	is.True(v.Validate())
+	is.Nil(v.FilteredData()["contact"])
+	is.Equal(" Inhere ", v.RawVal("contact"))
+
+	// condition field not exists
+	v = New(M{"contact": " Inhere "})
+	v.FilterRule("contact", "trim").SetCondition("type", "email")
+	is.True(v.Validate())
+	is.Nil(v.FilteredData()["contact"])
+
+	is.PanicsMsg(func() {
+		New(M{}).FilterRule("contact", "filter_if:type|trim")
+	}, "validate: filter_if: must provide the field and at least one value")
+}
+
+func TestFilter_collectErrors(t *testing.T) {
+	is := assert.New(t)
+
+	v := New(M{"age": "invalid", "score": "bad", "name": " inhere "})
+	v.AddFilter("myFilter", func(s string) (string, error) {
+		return s, fmt.Errorf("report a error")
+	})
+	v.CollectFilterErrors = true
+	v.FilterRule("age", "int")
+	v.FilterRule("score", "myFilter")
+	v.FilterRule("name", "trim")
+	v.StringRule("name", "required")
+
+	is.False(v.Validate())
+	is.False(v.Errors.HasField("_filter"))
+	is.True(v.Errors.HasField("age"))
+	is.Equal("report a error", v.Errors.FieldOne("score"))
+	is.False(v.Errors.HasField("name"))
+	is.Equal("inhere", v.Filtered("name"))
+}
+
+func TestFilter_hash(t *testing.T) {
+	is := assert.New(t)
+
+	str, err := Hash("hello", "sha256")
+	is.NoErr(err)
+	is.Equal("2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", str)
+	str, err = Hash("hello", "sha1")
+	is.NoErr(err)
+	is.Equal("aaf4c61ddcc5e8a2dabede0f3b482cd9aea9434d", str)
+	str, err = Hash("hello", "md5")
+	is.NoErr(err)
+	is.Equal("5d41402abc4b2a76b9719d911017c592", str)
+	_, err = Hash("hello", "crc32")
+	is.Err(err)
+
+	v := New(M{"key": "hello", "key2": "hello"})
+	v.FilterRule("key", "hash:sha256")
+	v.FilterRule("key2", "hash:md5")
+	is.True(v.Validate())
+	is.Equal("2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", v.Filtered("key"))
+	is.Equal("5d41402abc4b2a76b9719d911017c592", v.Filtered("key2"))
+
+	v = New(M{"key": "hello"})
+	v.FilterRule("key", "hash:crc32")
+	is.False(v.Validate())
+}
+
+func TestValidation_WithDefaultStringFilter(t *testing.T) {
+	is := assert.New(t)
+
+	v := New(M{"name": " inhere ", "city": "  Berlin", "email": " Some@Example.com ", "age": 23})
+	v.WithDefaultStringFilter("trim")
+	v.FilterRule("email", "lower")
+	is.True(v.Validate())
+	is.Equal("inhere", v.Filtered("name"))
+	is.Equal("Berlin", v.Filtered("city"))
+	// applied before the field filters
+	is.Equal("some@example.com", v.Filtered("email"))
+	is.Nil(v.Filtered("age"))
+	is.Equal(" inhere ", v.RawVal("name"))
+
+	// struct data
+	type user struct {
+		Name string
+		Nick string
+		Age  int
+	}
+	u := &user{Name: " tom ", Nick: "\tcat\n", Age: 3}
+	v = Struct(u).WithDefaultStringFilter("trim")
+	is.True(v.Validate())
+	is.Equal("tom", u.Name)
+	is.Equal("cat", u.Nick)
+	is.Equal(3, u.Age)
+
+	// string map data
+	v = New(map[string]string{"name": " inhere "}).WithDefaultStringFilter("trim")
+	is.True(v.Validate())
+	is.Equal("inhere", v.Filtered("name"))
+
+	// raw json data
+	d, err := FromRawJSON([]byte(`{"name": " inhere ", "age": 23}`))
+	is.NoErr(err)
+	v = d.Create().WithDefaultStringFilter("trim")
+	is.True(v.Validate())
+	is.Equal("inhere", v.Filtered("name"))
+	is.Nil(v.Filtered("age"))
+
+	// slice data
+	v = FromSlice([]string{" a ", "b "}).Create().WithDefaultStringFilter("trim")
+	is.True(v.Validate())
+	is.Equal("a", v.Filtered("0"))
+	is.Equal("b", v.Filtered("1"))
+}
@@ -20,74 +20,149 @@ import (
 // Unmarshal returns an InvalidUnmarshalError.
 // It can unmarshal data available in request/data param.
 func Unmarshal(r *http.Request, data []byte, v interface{}) (int, error) {
+	raw, code, err := readData(r, data)
+	if err != nil {
+		return code, err
+	}
+
+	d := json.NewDecoder(bytes.NewReader(raw))
+	// DisallowUnknownFields causes the Decoder to return an error when the destination
+	// is a struct and the input contains object keys which do not match any
+	// non-ignored, exported fields in the destination.
+	// d.DisallowUnknownFields()
+
+	// handle errors returned while decoding data into object.
+	if err := d.Decode(&v); err != nil {
+		return decodeError(err)
+	}
+	// NOTE: the trailing whitespace is allowed, d.More() will skip it.
+	if d.More() {
+		return http.StatusBadRequest, trailingDataError(raw, d.InputOffset())
+	}
+	return http.StatusOK, nil
+}
+
+// UnmarshalWith is like Unmarshal, but use the decode func to decode the JSON value into v.
+// the checks of the request and data are same as Unmarshal, eg: the content type, empty body and trailing data.
+//
+// Usage:
+//
+//	code, err := jsonutil.UnmarshalWith(sonic.Unmarshal, r, nil, &user)
+func UnmarshalWith(decode func(data []byte, v any) error, r *http.Request, data []byte, v interface{}) (int, error) {
+	raw, code, err := readData(r, data)
+	if err != nil {
+		return code, err
+	}
+
+	// only scan the first JSON value, the decoding is delegated to the decode func.
+	var msg json.RawMessage
+	d := json.NewDecoder(bytes.NewReader(raw))
+	if err := d.Decode(&msg); err != nil {
+		return decodeError(err)
+	}
+	if d.More() {
+		return http.StatusBadRequest, trailingDataError(raw, d.InputOffset())
+	}
+
+	if err := decode(msg, v); err != nil {
+		return http.StatusBadRequest, fmt.Errorf("failed to decode json %v", err)
+	}
+	return http.StatusOK, nil
+}
+
+// read the JSON data from the request body or data param.
+func readData(r *http.Request, data []byte) ([]byte, int, error) {
 	// ensure that some data is provided for unmarshalling
 	if r == nil && data == nil {
-		return http.StatusUnsupportedMediaType, fmt.Errorf("no data provided")
+		return nil, http.StatusUnsupportedMediaType, fmt.Errorf("no data provided")
 	} else if r != nil && data != nil {
 		// if someone sends multiple data for unmarshalling then it gives below error
-		return http.StatusUnsupportedMediaType, fmt.Errorf("multiple data provided for unmarshalling not supported")
+		return nil, http.StatusUnsupportedMediaType, fmt.Errorf("multiple data provided for unmarshalling not supported")
 	}
 
-	var d = &json.Decoder{}
 	// if "r" request is not empty then it will read data from request body to unmarshal that data into object provided in "v".
-	if r != nil && data == nil {
+	if r != nil {
 		// read request body as []byte.
 		bodyBytes, err := ioutil.ReadAll(r.Body)
 		if err != nil {
-			return http.StatusBadRequest, fmt.Errorf("error reading request body")
+			return nil, http.StatusBadRequest, fmt.Errorf("error reading request body")
 		}
 		// as we are only allowed to read request body once so we need to set request body after reading for further use of request data
 		// it will set request body which we have got in "bodyBytes" above.
 		r.Body = ioutil.NopCloser(bytes.NewBuffer(bodyBytes))
 
 		// check if content type is valid or not.
-		if !HasContentType(r, "application/json") {
-			return http.StatusUnsupportedMediaType, fmt.Errorf("content-type is not application/json")
+		if !HasAnyContentType(r, "application/json", "+json") {
+			return nil, http.StatusUnsupportedMediaType, fmt.Errorf("content-type is not application/json")
 		}
+		return bodyBytes, 0, nil
+	}
+	return data, 0, nil
+}
 
-		d = json.NewDecoder(bytes.NewReader(bodyBytes))
-	} else if data != nil && r == nil {
-		d = json.NewDecoder(bytes.NewReader(data))
+// convert the decode error to the status code and well defined error.
+func decodeError(err error) (int, error) {
+	var syntaxErr *json.SyntaxError
+	var unmarshalError *json.UnmarshalTypeError
+	switch {
+	case errors.As(err, &syntaxErr):
+		return http.StatusBadRequest, fmt.Errorf("malformed json at position %v", syntaxErr.Offset)
+	case errors.Is(err, io.ErrUnexpectedEOF):
+		return http.StatusBadRequest, fmt.Errorf("malformed json")
+	case errors.As(err, &unmarshalError):
+		return http.StatusBadRequest, fmt.Errorf("invalid value %v at position %v", unmarshalError.Field, unmarshalError.Offset)
+	case strings.HasPrefix(err.Error(), "json: unknown field"):
+		fieldName := strings.TrimPrefix(err.Error(), "json: unknown field ")
+		return http.StatusBadRequest, fmt.Errorf("unknown field %s", fieldName)
+	case errors.Is(err, io.EOF):
+		return http.StatusBadRequest, fmt.Errorf("body must not be empty")
+	case err.Error() == "http: request body too large":
+		return http.StatusRequestEntityTooLarge, err
+	default:
+		return http.StatusInternalServerError, fmt.Errorf("failed to decode json %v", err)
 	}
+}
 
-	// DisallowUnknownFields causes the Decoder to return an error when the destination
-	// is a struct and the input contains object keys which do not match any
-	// non-ignored, exported fields in the destination.
-	// d.DisallowUnknownFields()
+// build the trailing data error, contains the byte offset of the trailing data
+// and whether it looks like a second JSON value.
+func trailingDataError(raw []byte, offset int64) error {
+	pos := int(offset)
+	for pos < len(raw) && isJSONSpace(raw[pos]) {
+		pos++
+	}
 
-	// handle errors returned while decoding data into object.
-	if err := d.Decode(&v); err != nil {
-		var syntaxErr *json.SyntaxError
-		var unmarshalError *json.UnmarshalTypeError
-		switch {
-		case errors.As(err, &syntaxErr):
-			return http.StatusBadRequest, fmt.Errorf("malformed json at position %v", syntaxErr.Offset)
-		case errors.Is(err, io.ErrUnexpectedEOF):
-			return http.StatusBadRequest, fmt.Errorf("malformed json")
-		case errors.As(err, &unmarshalError):
-			return http.StatusBadRequest, fmt.Errorf("invalid value %v at position %v", unmarshalError.Field, unmarshalError.Offset)
-		case strings.HasPrefix(err.Error(), "json: unknown field"):
-			fieldName := strings.TrimPrefix(err.Error(), "json: unknown field ")
-			return http.StatusBadRequest, fmt.Errorf("unknown field %s", fieldName)
-		case errors.Is(err, io.EOF):
-			return http.StatusBadRequest, fmt.Errorf("body must not be empty")
-		case err.Error() == "http: request body too large":
-			return http.StatusRequestEntityTooLarge, err
-		default:
-			return http.StatusInternalServerError, fmt.Errorf("failed to decode json %v", err)
+	kind := "unexpected trailing data"
+	if pos < len(raw) {
+		switch c := raw[pos]; {
+		case c == '{':
+			kind = "a second JSON object"
+		case c == '[' || c == '"' || c == '-' || (c >= '0' && c <= '9'):
+			kind = "a second JSON value"
 		}
 	}
-	if d.More() {
-		return http.StatusBadRequest, fmt.Errorf("body must contain only one JSON object")
-	}
-	return http.StatusOK, nil
+	return fmt.Errorf("body must contain only one JSON object, found %s at position %d", kind, pos)
+}
+
+func isJSONSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
 }
 
 // check for valid content type.
 func HasContentType(r *http.Request, mimetype string) bool {
+	return HasAnyContentType(r, mimetype)
+}
+
+// HasAnyContentType check the request content type is match one of the mimetypes.
+// the mimetype start with "+" is a suffix match. eg: "+json" match "application/vnd.api+json"
+func HasAnyContentType(r *http.Request, mimetypes ...string) bool {
 	contentType := r.Header.Get("Content-type")
 	if contentType == "" {
-		return mimetype == "application/octet-stream"
+		for _, mimetype := range mimetypes {
+			if mimetype == "application/octet-stream" {
+				return true
+			}
+		}
+		return false
 	}
 
 	for _, v := range strings.Split(contentType, ",") {
@@ -95,9 +170,12 @@ func HasContentType(r *http.Request, mimetype string) bool {
 		if err != nil {
 			break
 		}
-		if t == mimetype {
-			return true
+
+		for _, mimetype := range mimetypes {
+			if t == mimetype || (strings.HasPrefix(mimetype, "+") && strings.HasSuffix(t, mimetype)) {
+				return true
+			}
 		}
 	}
 	return false
-}
\ No newline at end of file
+}
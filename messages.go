@@ -5,6 +5,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/url"
+	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -42,6 +45,31 @@ var (
 //	}
 type Errors map[string]MS
 
+// FieldError an validate error of the field. see Validation.FirstError
+type FieldError struct {
+	// Field name, is translated by the field name map.
+	Field string
+	// Validator name of the failed rule.
+	Validator string
+	// Message the error message
+	Message string
+}
+
+// Error string get
+func (e *FieldError) Error() string {
+	return e.Message
+}
+
+// FieldSource the source struct field info of a field error. see Validation.DebugErrors
+type FieldSource struct {
+	// Path the struct field path. eg: "Home.City"
+	Path string
+	// Type string of the field type. eg: "*string" "[]int"
+	Type string
+	// Kind of the field type, the pointer is removed.
+	Kind reflect.Kind
+}
+
 // Empty no error
 func (es Errors) Empty() bool {
 	return len(es) == 0
@@ -126,6 +154,183 @@ func (es Errors) String() string {
 	return strings.TrimSpace(buf.String())
 }
 
+// FieldCode definition. the field with the error code
+type FieldCode struct {
+	Field string
+	// Code the error code, format: "field.validator". eg: "name.required"
+	Code string
+}
+
+// build the error code, the validator alias name will be converted to the real name.
+// eg: "email", "minLen" -> "email.minLength"
+func newFieldCode(field, validator string) FieldCode {
+	return FieldCode{Field: field, Code: field + "." + ValidatorName(validator)}
+}
+
+// Codes get all error codes, sorted by the codes.
+//
+// The code format is "field.validator", the validator is the real name. eg: "in" -> "enum".
+// it is stable and can be used for i18n on frontend.
+func (es Errors) Codes() []FieldCode {
+	codes := make([]FieldCode, 0, len(es))
+	seen := make(map[string]bool, len(es))
+	for field, fe := range es {
+		for validator := range fe {
+			// the alias names of a validator are same code
+			if fc := newFieldCode(field, validator); !seen[fc.Code] {
+				seen[fc.Code] = true
+				codes = append(codes, fc)
+			}
+		}
+	}
+
+	sort.Slice(codes, func(i, j int) bool {
+		return codes[i].Code < codes[j].Code
+	})
+	return codes
+}
+
+// FieldViolation definition. same as the "google.rpc.BadRequest.FieldViolation", can be easily mapped to the proto.
+type FieldViolation struct {
+	// Field the path to the field. eg: "name", "user.email"
+	Field string
+	// Description the error message of the field
+	Description string
+}
+
+// ToFieldViolations convert the errors to field violations, sorted by field and validator.
+// each validator error of the field is a violation.
+//
+// Usage:
+//
+//	br := &errdetails.BadRequest{}
+//	for _, fv := range v.Errors.ToFieldViolations() {
+//		br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+//			Field:       fv.Field,
+//			Description: fv.Description,
+//		})
+//	}
+func (es Errors) ToFieldViolations() []FieldViolation {
+	violations := make([]FieldViolation, 0, len(es))
+	es.eachSorted(func(field, _, msg string) {
+		violations = append(violations, FieldViolation{Field: field, Description: msg})
+	})
+	return violations
+}
+
+// ToURLValues convert the errors to url.Values, the key is field, the values is
+// the error messages of the field, sorted by validator. useful for rendering the form template.
+//
+// Usage:
+//
+//	tpl.Execute(w, map[string]any{"errors": v.Errors.ToURLValues()})
+//	// in template: {{ .errors.Get "name" }}
+func (es Errors) ToURLValues() url.Values {
+	values := make(url.Values, len(es))
+	es.eachSorted(func(field, _, msg string) {
+		values.Add(field, msg)
+	})
+	return values
+}
+
+// iterate the errors, sorted by field and validator.
+func (es Errors) eachSorted(fn func(field, validator, msg string)) {
+	fields := make([]string, 0, len(es))
+	for field := range es {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		validators := make([]string, 0, len(es[field]))
+		for validator := range es[field] {
+			validators = append(validators, validator)
+		}
+		sort.Strings(validators)
+
+		for _, validator := range validators {
+			fn(field, validator, es[field][validator])
+		}
+	}
+}
+
+// ErrorFormatter format the errors to the custom output shape. see Validation.FormatErrors
+type ErrorFormatter interface {
+	Format(e Errors) any
+}
+
+// MapErrorFormatter format the errors to map[string][]string, the key is field,
+// the value is error messages of the field, sorted by validator.
+//
+// Output like:
+//
+//	{"age": ["age min value is 1"], "name": ["name is required to not be empty"]}
+type MapErrorFormatter struct{}
+
+// Format the errors
+func (MapErrorFormatter) Format(e Errors) any {
+	mp := make(map[string][]string, len(e))
+	e.eachSorted(func(field, _, msg string) {
+		mp[field] = append(mp[field], msg)
+	})
+	return mp
+}
+
+// SliceErrorFormatter format the errors to []FieldError, sorted by field and validator.
+//
+// Output like:
+//
+//	[{"Field": "age", "Validator": "min", "Message": "age min value is 1"}]
+type SliceErrorFormatter struct{}
+
+// Format the errors
+func (SliceErrorFormatter) Format(e Errors) any {
+	list := make([]FieldError, 0, len(e))
+	e.eachSorted(func(field, validator, msg string) {
+		list = append(list, FieldError{Field: field, Validator: validator, Message: msg})
+	})
+	return list
+}
+
+// MergeStrategy the strategy on merge errors with the same field. see Errors.MergeWith
+type MergeStrategy uint8
+
+// the merge strategies
+const (
+	// MergeAppend append the other field errors, on the same validator the messages will be joined. default
+	MergeAppend MergeStrategy = iota
+	// MergeKeepFirst keep the current field errors, ignore the other errors of the field
+	MergeKeepFirst
+	// MergeKeepLast use the other field errors to replace the current
+	MergeKeepLast
+)
+
+// MergeWith merge the other errors to the current errors.
+// on both have errors for the same field, handle by the strategy.
+func (es Errors) MergeWith(other Errors, strategy MergeStrategy) {
+	for field, ofe := range other {
+		fe, ok := es[field]
+		if !ok || strategy == MergeKeepLast {
+			es[field] = make(MS, len(ofe))
+			for validator, msg := range ofe {
+				es[field][validator] = msg
+			}
+			continue
+		}
+
+		if strategy == MergeKeepFirst {
+			continue
+		}
+
+		for validator, msg := range ofe {
+			if old, has := fe[validator]; has && old != msg {
+				msg = old + "; " + msg
+			}
+			fe[validator] = msg
+		}
+	}
+}
+
 // HasField in the errors
 func (es Errors) HasField(field string) bool {
 	_, ok := es[field]
@@ -170,6 +375,7 @@ var builtinMessages = map[string]string{
 	// length
 	"minLength": "{field} min length is %d",
 	"maxLength": "{field} max length is %d",
+	"bytesMax":  "{field} max byte length is %d",
 	// string length. calc rune
 	"stringLength":  "{field} length must be in the range %d - %d",
 	"stringLength1": "{field} min length is %d",
@@ -177,16 +383,38 @@ var builtinMessages = map[string]string{
 
 	"isURL":     "{field} must be a valid URL address",
 	"isFullURL": "{field} must be a valid full URL address",
-	"regexp":    "{field} must match pattern %s",
+	"isJWT":     "{field} must be a valid JSON Web Token",
+	"isRegex":   "{field} must be a valid regular expression: %s",
+	// iso codes
+	"isCountryCode":  "{field} must be a valid ISO 3166-1 alpha-2 country code",
+	"isCurrencyCode": "{field} must be a valid ISO 4217 currency code",
+	"isLanguageCode": "{field} must be a valid ISO 639-1 language code",
+	"regexp":         "{field} must match pattern %s",
+	"glob":           "{field} must match glob pattern {args0}",
 
 	"isFile":  "{field} must be an uploaded file",
 	"isImage": "{field} must be an uploaded image file",
-
-	"enum":  "{field} value must be in the enum %v",
-	"range": "{field} value must be in the range %d - %d",
+	// image dimensions
+	"imageDimensions": "{field} image dimensions must match the limits {values}",
+
+	"enum":          "{field} value must be in the enum %v",
+	"inNumbers":     "{field} value must be one of the numbers {values}",
+	"containsValue": "{field} value must contain all of {values}",
+	"indexIn":       "{field} value must be a valid index of the set %s",
+	"notCommon":     "{field} value is too common",
+	"member":        "{field} value must be a member of %s",
+	"notMember":     "{field} value must not be a member of %s",
+	"minEntropy":    "{field} value is too weak, the entropy must be at least %v bits",
+	"range":         "{field} value must be in the range %d - %d",
+	"multipleOf":    "{field} value must be a multiple of %v",
 	// int compare
 	"lt": "{field} value should be less than %v",
 	"gt": "{field} value should be greater than %v",
+	// number sign
+	"positive":    "{field} value must be a positive number",
+	"negative":    "{field} value must be a negative number",
+	"nonNegative": "{field} value must be a non-negative number",
+	"nonPositive": "{field} value must be a non-positive number",
 	// required
 	"required":           "{field} is required to not be empty",
 	"requiredIf":         "{field} is required when {args0} is in {args1end}",
@@ -195,13 +423,25 @@ var builtinMessages = map[string]string{
 	"requiredWithAll":    "{field} field is required when {values} is present",
 	"requiredWithout":    "{field} field is required when {values} is not present",
 	"requiredWithoutAll": "{field} field is required when none of {values} are present",
+	"requiredAtLeast":    "{field} requires at least %v of %v to be present, but found %v",
+	"requiredTogether":   "{field} requires %v to be present together, missing %v",
+	"requiredKeys":       "{field} is missing the required keys: %v",
+	"allowedKeys":        "{field} contains the keys that are not allowed: %v",
+	"noEmptyItems":       "{field} must not contain empty items, the item at index %d is empty",
+	"uniqueFold":         "{field} items must be unique ignoring case, the item %q at index %d duplicates %q at index %d",
+	// forbidden
+	"forbidden":   "{field} is not allowed to be submitted",
+	"forbiddenIf": "{field} is not allowed when {args0} is in {args1end}",
 	// field compare
-	"eqField":  "{field} value must be equal the field %s",
-	"neField":  "{field} value cannot be equal to the field %s",
-	"ltField":  "{field} value should be less than the field %s",
-	"lteField": "{field} value should be less than or equal to the field %s",
-	"gtField":  "{field} value must be greater than the field %s",
-	"gteField": "{field} value should be greater or equal to the field %s",
+	"eqField":    "{field} value must be equal the field %s",
+	"neField":    "{field} value cannot be equal to the field %s",
+	"ltField":    "{field} value should be less than the field %s",
+	"lteField":   "{field} value should be less than or equal to the field %s",
+	"lenEqField": "{field} length must be equal to the field %v value %v, but got length %v",
+	"hmac":       "{field} value does not match the signature",
+	"sameLen":    "{field} length must be equal to the length of the field %v, but got %v and %v",
+	"gtField":    "{field} value must be greater than the field %s",
+	"gteField":   "{field} value should be greater or equal to the field %s",
 	// data type
 	"bool":    "{field} value must be a bool",
 	"float":   "{field} value must be a float",
@@ -210,6 +450,27 @@ var builtinMessages = map[string]string{
 	"array":   "{field} value must be an array",
 	"strings": "{field} value must be a []string",
 	"notIn":   "{field} value must not be in the given enum list %d",
+	// numeric type range
+	"isInt8":    "{field} value must be a number in the int8 range",
+	"isInt16":   "{field} value must be a number in the int16 range",
+	"isInt32":   "{field} value must be a number in the int32 range",
+	"isInt64":   "{field} value must be a number in the int64 range",
+	"isUint8":   "{field} value must be a number in the uint8 range",
+	"isUint16":  "{field} value must be a number in the uint16 range",
+	"isUint32":  "{field} value must be a number in the uint32 range",
+	"isUint64":  "{field} value must be a number in the uint64 range",
+	"isFloat32": "{field} value must be a number in the float32 range",
+	"isFloat64": "{field} value must be a number in the float64 range",
+	"numType":   "{field} value is not a number or out of range of the numeric type",
+	"isInteger": "{field} value must be an integer without fractional part",
+	// decimal places
+	"decimals":      "{field} value must be a number with at most %v decimal places",
+	"decimalsExact": "{field} value must be a number with exactly %v decimal places",
+	// sorted
+	"isSorted":  "{field} value must be sorted",
+	"isSorted1": "{field} value must be sorted in %s order",
+	// report the first out of order index. see Rule.errorMessage
+	"isSortedIndex": "{field} value must be sorted in %s order, the item at index %d is out of order",
 	//
 	"contains":    "{field} value does not contain %s",
 	"notContains": "{field} value contains %s",
@@ -220,49 +481,69 @@ var builtinMessages = map[string]string{
 	"file":        "{field} value must be a file",
 	"image":       "{field} value must be an image",
 	// date
-	"date":    "{field} value should be a date string",
-	"gtDate":  "{field} value should be after %s",
-	"ltDate":  "{field} value should be before %s",
-	"gteDate": "{field} value should be after or equal to %s",
-	"lteDate": "{field} value should be before or equal to %s",
+	"date":       "{field} value should be a date string",
+	"dateTime":   "{field} value should be a date time string of the layouts: %s",
+	"format":     "{field} value should be a valid %s format",
+	"isCron":     "{field} value should be a valid cron expression",
+	"gtDate":     "{field} value should be after %s",
+	"ltDate":     "{field} value should be before %s",
+	"gteDate":    "{field} value should be after or equal to %s",
+	"lteDate":    "{field} value should be before or equal to %s",
+	"minAge":     "{field} age should be at least %v",
+	"maxAge":     "{field} age should be at most %v",
+	"withinDays": "{field} should be within %v days of %v",
+	"minDays":    "{field} should be at least %v days from %v",
 	// check char
-	"hasWhitespace":  "{field} value should contains spaces",
-	"ascii":          "{field} value should be an ASCII string",
-	"alpha":          "{field} value contains only alpha char",
-	"alphaNum":       "{field} value contains only alpha char and num",
-	"alphaDash":      "{field} value contains only letters, num, dashes (-) and underscores (_)",
-	"multiByte":      "{field} value should be a multiByte string",
-	"base64":         "{field} value should be a base64 string",
-	"dnsName":        "{field} value should be a DNS string",
-	"dataURI":        "{field} value should be a DataURL string",
-	"empty":          "{field} value should be empty",
-	"hexColor":       "{field} value should be a color string in hexadecimal",
-	"hexadecimal":    "{field} value should be a hexadecimal string",
-	"json":           "{field} value should be a json string",
-	"lat":            "{field} value should be a latitude coordinate",
-	"lon":            "{field} value should be a longitude coordinate",
-	"num":            "{field} value should be a num (>=0) string",
-	"mac":            "{field} value should be a MAC address",
-	"cnMobile":       "{field} value should be string of Chinese 11-digit mobile phone numbers",
-	"printableASCII": "{field} value should be a printable ASCII string",
-	"rgbColor":       "{field} value should be a RGB color string",
-	"fullURL":        "{field} value should be a complete URL string",
-	"full":           "{field} value should be a URL string",
-	"ip":             "{field} value should be an IP (v4 or v6) string",
-	"ipv4":           "{field} value should be an IPv4 string",
-	"ipv6":           "{field} value should be an IPv6 string",
-	"CIDR":           "{field} value should be a CIDR string",
-	"CIDRv4":         "{field} value should be a CIDRv4 string",
-	"CIDRv6":         "{field} value should be a CIDRv6 string",
-	"uuid":           "{field} value should be a UUID string",
-	"uuid3":          "{field} value should be a UUID3 string",
-	"uuid4":          "{field} value should be a UUID4 string",
-	"uuid5":          "{field} value should be a UUID5 string",
-	"filePath":       "{field} value should be an existing file path",
-	"unixPath":       "{field} value should be a unix path string",
-	"winPath":        "{field} value should be a windows path string",
-	"isbn10":         "{field} value should be a isbn10 string",
-	"isbn13":         "{field} value should be a isbn13 string",
+	"hasWhitespace":      "{field} value should contains spaces",
+	"ascii":              "{field} value should be an ASCII string",
+	"alpha":              "{field} value contains only alpha char",
+	"alphaNum":           "{field} value contains only alpha char and num",
+	"alphaDash":          "{field} value contains only letters, num, dashes (-) and underscores (_)",
+	"multiByte":          "{field} value should be a multiByte string",
+	"base64":             "{field} value should be a base64 string",
+	"dnsName":            "{field} value should be a DNS string",
+	"dataURI":            "{field} value should be a DataURL string",
+	"isMediaType":        "{field} value should be a valid media type",
+	"hasPublicSuffix":    "{field} value should be a domain with a valid public suffix",
+	"empty":              "{field} value should be empty",
+	"hexColor":           "{field} value should be a color string in hexadecimal",
+	"hexadecimal":        "{field} value should be a hexadecimal string",
+	"json":               "{field} value should be a json string",
+	"isJSONPointer":      "{field} value should be a valid JSON pointer",
+	"isJSONPath":         "{field} value should be a valid JSON path",
+	"isYAML":             "{field} value should be a valid YAML string",
+	"isYAMLMap":          "{field} value should be a YAML string of mapping",
+	"isHTMLValid":        "{field} value should be well-formed HTML, all tags must be closed",
+	"lat":                "{field} value should be a latitude coordinate",
+	"lon":                "{field} value should be a longitude coordinate",
+	"num":                "{field} value should be a num (>=0) string",
+	"mac":                "{field} value should be a MAC address",
+	"cnMobile":           "{field} value should be string of Chinese 11-digit mobile phone numbers",
+	"isE164":             "{field} value should be an E.164 phone number",
+	"printableASCII":     "{field} value should be a printable ASCII string",
+	"printable":          "{field} value should not contain non-printable characters",
+	"noSurroundingSpace": "{field} value should not have leading or trailing whitespace",
+	"noLeadingZero":      "{field} value should not have leading zeros",
+	"rgbColor":           "{field} value should be a RGB color string",
+	"color":              "{field} value should be a valid color",
+	"fullURL":            "{field} value should be a complete URL string",
+	"full":               "{field} value should be a URL string",
+	"ip":                 "{field} value should be an IP (v4 or v6) string",
+	"ipv4":               "{field} value should be an IPv4 string",
+	"ipv6":               "{field} value should be an IPv6 string",
+	"CIDR":               "{field} value should be a CIDR string",
+	"CIDRv4":             "{field} value should be a CIDRv4 string",
+	"CIDRv6":             "{field} value should be a CIDRv6 string",
+	"uuid":               "{field} value should be a UUID string",
+	"uuid3":              "{field} value should be a UUID3 string",
+	"uuid4":              "{field} value should be a UUID4 string",
+	"uuid5":              "{field} value should be a UUID5 string",
+	"filePath":           "{field} value should be an existing file path",
+	"unixPath":           "{field} value should be a unix path string",
+	"winPath":            "{field} value should be a windows path string",
+	"isValidPath":        "{field} value should be a valid path",
+	"isbn10":             "{field} value should be a isbn10 string",
+	"isbn13":             "{field} value should be a isbn13 string",
 }
 
 // AddGlobalMessages add global builtin messages
@@ -290,6 +571,87 @@ func CopyGlobalMessages() map[string]string {
 // SetBuiltinMessages override set builtin messages
 func SetBuiltinMessages(mp map[string]string) { builtinMessages = mp }
 
+// the loaded locale messages. format: {locale: {validator: message}}
+var localeMessages = map[string]map[string]string{}
+
+// LoadLocaleMessages load the locale messages from JSON data, the data must be an object of "key: message".
+// loading the same locale again will merge the messages.
+//
+// Usage:
+//
+//	bs, _ := os.ReadFile("locales/fr.json")
+//	err := validate.LoadLocaleMessages("fr", bs)
+//	// use it
+//	v.WithLocale("fr")
+func LoadLocaleMessages(locale string, data []byte) error {
+	if locale == "" {
+		return errors.New("validate: the locale name cannot be empty")
+	}
+
+	mp := make(map[string]string)
+	if err := json.Unmarshal(data, &mp); err != nil {
+		return fmt.Errorf("validate: invalid messages data of the locale '%s': %w", locale, err)
+	}
+
+	if lm, ok := localeMessages[locale]; ok {
+		for key, msg := range mp {
+			lm[key] = msg
+		}
+	} else {
+		localeMessages[locale] = mp
+	}
+	return nil
+}
+
+// LocaleMessages get the loaded messages of the locale, returns nil on not loaded.
+func LocaleMessages(locale string) map[string]string {
+	return localeMessages[locale]
+}
+
+// the validator argument names, use for the named placeholders in message.
+// eg: "{field} must be between {min} and {max}"
+var validatorArgNames = map[string][]string{
+	"min":          {"min"},
+	"max":          {"max"},
+	"lt":           {"max"},
+	"gt":           {"min"},
+	"between":      {"min", "max"},
+	"minLength":    {"min"},
+	"maxLength":    {"max"},
+	"bytesMax":     {"max"},
+	"length":       {"length"},
+	"stringLength": {"min", "max"},
+	"multipleOf":   {"multiple"},
+}
+
+// AddArgNames register the argument names for the validator.
+// The names can be used as named placeholders in the error message.
+//
+// Usage:
+//
+//	validate.AddArgNames("checkRange", "min", "max")
+//	validate.AddGlobalMessages(map[string]string{
+//		"checkRange": "{field} must be between {min} and {max}",
+//	})
+func AddArgNames(validator string, names ...string) {
+	if rName, has := validatorAliases[validator]; has {
+		validator = rName
+	}
+	validatorArgNames[validator] = names
+}
+
+// get the argument names for the validator
+func argNames(validator string) []string {
+	if names, ok := validatorArgNames[validator]; ok {
+		return names
+	}
+
+	if rName, has := validatorAliases[validator]; has {
+		return validatorArgNames[rName]
+	}
+	return nil
+}
+
 /*************************************************************
  * Error messages translator
  *************************************************************/
@@ -435,11 +797,11 @@ func (t *Translator) Message(validator, field string, args ...any) (msg string)
 		}
 	}
 
-	return t.format(errMsg, field, args)
+	return t.format(errMsg, field, args, argNames(validator)...)
 }
 
 // format message for the validator
-func (t *Translator) format(errMsg, field string, args []any) string {
+func (t *Translator) format(errMsg, field string, args []any, names ...string) string {
 	argLen := len(args)
 
 	// fix: #111 argN maybe is a field name
@@ -479,6 +841,13 @@ func (t *Translator) format(errMsg, field string, args []any) string {
 			msgArgs = append(msgArgs, "{args1end}", arrutil.ToString(args[1:]))
 		}
 
+		// named args. eg: {min} {max}
+		for i, name := range names {
+			if i < argLen {
+				msgArgs = append(msgArgs, "{"+name+"}", strutil.SafeString(args[i]))
+			}
+		}
+
 		// replace message vars
 		errMsg = strings.NewReplacer(msgArgs...).Replace(errMsg)
 	} else {
@@ -2,6 +2,7 @@ package validate
 
 import (
 	"fmt"
+	"net/url"
 	"testing"
 
 	"github.com/gookit/goutil/dump"
@@ -56,6 +57,138 @@ func TestErrorsBasic(t *testing.T) {
 	dump.V(es)
 }
 
+func TestErrors_Codes(t *testing.T) {
+	is := assert.New(t)
+	is.Empty(Errors{}.Codes())
+
+	v := New(M{"name": "", "email": "invalid"})
+	v.StringRule("name", "required")
+	v.StringRule("email", "required|email")
+	is.False(v.Validate())
+
+	is.Equal([]FieldCode{
+		{Field: "email", Code: "email.isEmail"},
+		{Field: "name", Code: "name.required"},
+	}, v.Errors.Codes())
+
+	// recorded on AddError, the alias name is normalized
+	v = New(M{"name": "i", "role": "guest"})
+	v.StopOnError = false
+	v.StringRule("name", "minLen:2")
+	v.StringRule("role", "in:admin,user")
+	is.False(v.Validate())
+	v.AddError("name", "min_len", "other message")
+	v.AddError("role", "notIn", "other message")
+	is.Equal([]FieldCode{
+		{Field: "name", Code: "name.minLength"},
+		{Field: "role", Code: "role.enum"},
+		{Field: "role", Code: "role.notIn"},
+	}, v.ErrorCodes())
+	is.Equal([]FieldCode{
+		{Field: "name", Code: "name.minLength"},
+		{Field: "role", Code: "role.enum"},
+		{Field: "role", Code: "role.notIn"},
+	}, v.Errors.Codes())
+}
+
+func TestErrors_ToFieldViolations(t *testing.T) {
+	is := assert.New(t)
+	is.Empty(Errors{}.ToFieldViolations())
+
+	v := New(M{"name": "", "email": "invalid"})
+	v.StopOnError = false
+	v.StringRule("name", "required")
+	v.StringRule("email", "email")
+	is.False(v.Validate())
+
+	is.Equal([]FieldViolation{
+		{Field: "email", Description: "email value is an invalid email address"},
+		{Field: "name", Description: "name is required to not be empty"},
+	}, v.Errors.ToFieldViolations())
+}
+
+func TestErrors_ToURLValues(t *testing.T) {
+	is := assert.New(t)
+	is.Empty(Errors{}.ToURLValues())
+
+	v := New(M{"name": "", "email": "invalid"})
+	v.StopOnError = false
+	v.StringRule("name", "required")
+	v.StringRule("email", "email|minLen:10")
+	is.False(v.Validate())
+
+	vs := v.Errors.ToURLValues()
+	is.Equal("name is required to not be empty", vs.Get("name"))
+	is.Equal([]string{
+		"email value is an invalid email address",
+		"email min length is 10",
+	}, vs["email"])
+	is.Len(vs["email"], len(v.Errors.Field("email")))
+
+	// round-trip by encode
+	parsed, err := url.ParseQuery(vs.Encode())
+	is.NoErr(err)
+	is.Equal(vs, parsed)
+}
+
+func TestValidation_FormatErrors(t *testing.T) {
+	is := assert.New(t)
+
+	v := New(M{"name": "", "email": "invalid", "age": 200})
+	v.StopOnError = false
+	v.StringRule("name", "required")
+	v.StringRule("email", "email")
+	v.StringRule("age", "max:99|multipleOf:3")
+	is.False(v.Validate())
+
+	is.Equal(map[string][]string{
+		"age":   {"age max value is 99", "age value must be a multiple of 3"},
+		"email": {"email value is an invalid email address"},
+		"name":  {"name is required to not be empty"},
+	}, v.FormatErrors(MapErrorFormatter{}))
+
+	is.Equal([]FieldError{
+		{Field: "age", Validator: "max", Message: "age max value is 99"},
+		{Field: "age", Validator: "multipleOf", Message: "age value must be a multiple of 3"},
+		{Field: "email", Validator: "email", Message: "email value is an invalid email address"},
+		{Field: "name", Validator: "required", Message: "name is required to not be empty"},
+	}, v.FormatErrors(SliceErrorFormatter{}))
+
+	// no errors
+	is.Empty(New(M{}).FormatErrors(SliceErrorFormatter{}))
+}
+
+func TestErrors_MergeWith(t *testing.T) {
+	is := assert.New(t)
+	newErrs := func() (Errors, Errors) {
+		es := Errors{"name": {"required": "name is required"}}
+		other := Errors{
+			"name": {"required": "name must be set", "minLen": "name is too short"},
+			"age":  {"min": "age is too small"},
+		}
+		return es, other
+	}
+
+	// append
+	es, other := newErrs()
+	es.MergeWith(other, MergeAppend)
+	is.Equal("name is required; name must be set", es["name"]["required"])
+	is.Equal("name is too short", es["name"]["minLen"])
+	is.Equal("age is too small", es.FieldOne("age"))
+
+	// keep first
+	es, other = newErrs()
+	es.MergeWith(other, MergeKeepFirst)
+	is.Equal(MS{"required": "name is required"}, es["name"])
+	is.True(es.HasField("age"))
+
+	// keep last
+	es, other = newErrs()
+	es.MergeWith(other, MergeKeepLast)
+	is.Equal(MS{"required": "name must be set", "minLen": "name is too short"}, es["name"])
+	is.True(es.HasField("age"))
+}
+
 func TestTranslatorBasic(t *testing.T) {
 	tr := NewTranslator()
 
@@ -77,6 +210,52 @@ func TestTranslatorBasic(t *testing.T) {
 	tr.Reset()
 }
 
+func TestTranslator_namedArgs(t *testing.T) {
+	is := assert.New(t)
+
+	v := New(M{"age": 30})
+	v.StringRule("age", "range:1,20")
+	v.WithMessages(map[string]string{
+		"range": "{field} must be between {min} and {max}",
+	})
+	is.False(v.Validate())
+	is.Equal("age must be between 1 and 20", v.Errors.One())
+
+	// custom validator with arg names
+	AddArgNames("checkRange", "min", "max")
+	tr := NewTranslator()
+	tr.AddMessage("checkRange", "{field} must be between {min} and {max}")
+	is.Equal("age must be between 1 and 20", tr.Message("checkRange", "age", 1, 20))
+}
+
+func TestLoadLocaleMessages(t *testing.T) {
+	is := assert.New(t)
+
+	is.Err(LoadLocaleMessages("", []byte(`{}`)))
+	is.Err(LoadLocaleMessages("fr", []byte(`{"required": 23}`)))
+	is.Err(LoadLocaleMessages("fr", []byte(`["required"]`)))
+	is.Nil(LocaleMessages("fr"))
+
+	err := LoadLocaleMessages("fr", []byte(`{"required": "{field} est obligatoire"}`))
+	is.NoErr(err)
+	err = LoadLocaleMessages("fr", []byte(`{"minLen": "{field} doit contenir au moins %d caractères"}`))
+	is.NoErr(err)
+	is.Len(LocaleMessages("fr"), 2)
+	defer delete(localeMessages, "fr")
+
+	v := New(M{"code": "ab"}).WithLocale("fr")
+	v.StopOnError = false
+	v.StringRule("name", "required")
+	v.StringRule("code", "minLen:3")
+	is.False(v.Validate())
+	is.Equal("name est obligatoire", v.Errors.FieldOne("name"))
+	is.Equal("code doit contenir au moins 3 caractères", v.Errors.FieldOne("code"))
+
+	is.Panics(func() {
+		New(M{}).WithLocale("de")
+	})
+}
+
 func TestUseAliasMessageKey(t *testing.T) {
 	is := assert.New(t)
 	v := New(M{
@@ -36,27 +36,59 @@ var validatorValues = map[string]reflect.Value{
 	"gt":  reflect.ValueOf(Gt),
 	"min": reflect.ValueOf(Min),
 	"max": reflect.ValueOf(Max),
+	// number sign
+	"positive":    reflect.ValueOf(Positive),
+	"negative":    reflect.ValueOf(Negative),
+	"nonNegative": reflect.ValueOf(NonNegative),
+	"nonPositive": reflect.ValueOf(NonPositive),
 	// value check
-	"enum":     reflect.ValueOf(Enum),
-	"notIn":    reflect.ValueOf(NotIn),
-	"between":  reflect.ValueOf(Between),
-	"regexp":   reflect.ValueOf(Regexp),
-	"isEqual":  reflect.ValueOf(IsEqual),
-	"intEqual": reflect.ValueOf(IntEqual),
-	"notEqual": reflect.ValueOf(NotEqual),
+	"enum":       reflect.ValueOf(Enum),
+	"notIn":      reflect.ValueOf(NotIn),
+	"inNumbers":  reflect.ValueOf(InNumbers),
+	"indexIn":    reflect.ValueOf(IndexIn),
+	"notCommon":  reflect.ValueOf(NotCommon),
+	"member":     reflect.ValueOf(Member),
+	"notMember":  reflect.ValueOf(NotMember),
+	"minEntropy": reflect.ValueOf(MinEntropy),
+	"isSorted":   reflect.ValueOf(IsSorted),
+	"between":    reflect.ValueOf(Between),
+	"multipleOf": reflect.ValueOf(MultipleOf),
+	"regexp":     reflect.ValueOf(Regexp),
+	"glob":       reflect.ValueOf(Glob),
+	"isEqual":    reflect.ValueOf(IsEqual),
+	"intEqual":   reflect.ValueOf(IntEqual),
+	"notEqual":   reflect.ValueOf(NotEqual),
 	// contains
-	"contains":    reflect.ValueOf(Contains),
-	"notContains": reflect.ValueOf(NotContains),
+	"contains":      reflect.ValueOf(Contains),
+	"notContains":   reflect.ValueOf(NotContains),
+	"containsValue": reflect.ValueOf(ContainsValue),
+	"allowedKeys":   reflect.ValueOf(AllowedKeys),
+	"noEmptyItems":  reflect.ValueOf(NoEmptyItems),
+	"uniqueFold":    reflect.ValueOf(UniqueFold),
 	// string contains
 	"stringContains": reflect.ValueOf(StringContains),
 	"startsWith":     reflect.ValueOf(StartsWith),
 	"endsWith":       reflect.ValueOf(EndsWith),
 	// data type check
-	"isInt":     reflect.ValueOf(IsInt),
-	"isMap":     reflect.ValueOf(IsMap),
-	"isUint":    reflect.ValueOf(IsUint),
-	"isBool":    reflect.ValueOf(IsBool),
-	"isFloat":   reflect.ValueOf(IsFloat),
+	"isInt":   reflect.ValueOf(IsInt),
+	"isMap":   reflect.ValueOf(IsMap),
+	"isUint":  reflect.ValueOf(IsUint),
+	"isBool":  reflect.ValueOf(IsBool),
+	"isFloat": reflect.ValueOf(IsFloat),
+	// numeric type range
+	"isInt8":    reflect.ValueOf(IsInt8),
+	"isInt16":   reflect.ValueOf(IsInt16),
+	"isInt32":   reflect.ValueOf(IsInt32),
+	"isInt64":   reflect.ValueOf(IsInt64),
+	"isUint8":   reflect.ValueOf(IsUint8),
+	"isUint16":  reflect.ValueOf(IsUint16),
+	"isUint32":  reflect.ValueOf(IsUint32),
+	"isUint64":  reflect.ValueOf(IsUint64),
+	"isFloat32": reflect.ValueOf(IsFloat32),
+	"isFloat64": reflect.ValueOf(IsFloat64),
+	"isInteger": reflect.ValueOf(IsInteger),
+	"numType":   reflect.ValueOf(IsNumOf),
+	"decimals":  reflect.ValueOf(Decimals),
 	"isInts":    reflect.ValueOf(IsInts),
 	"isArray":   reflect.ValueOf(IsArray),
 	"isSlice":   reflect.ValueOf(IsSlice),
@@ -67,42 +99,60 @@ var validatorValues = map[string]reflect.Value{
 	"minLength":    reflect.ValueOf(MinLength),
 	"maxLength":    reflect.ValueOf(MaxLength),
 	"stringLength": reflect.ValueOf(StringLength),
+	"bytesMax":     reflect.ValueOf(BytesMax),
 	// string
 	"isIntString": reflect.ValueOf(IsIntString),
 	// ip
-	"isIP":        reflect.ValueOf(IsIP),
-	"isIPv4":      reflect.ValueOf(IsIPv4),
-	"isIPv6":      reflect.ValueOf(IsIPv6),
-	"isEmail":     reflect.ValueOf(IsEmail),
-	"isASCII":     reflect.ValueOf(IsASCII),
-	"isAlpha":     reflect.ValueOf(IsAlpha),
-	"isAlphaNum":  reflect.ValueOf(IsAlphaNum),
-	"isAlphaDash": reflect.ValueOf(IsAlphaDash),
-	"isBase64":    reflect.ValueOf(IsBase64),
-	"isCIDR":      reflect.ValueOf(IsCIDR),
-	"isCIDRv4":    reflect.ValueOf(IsCIDRv4),
-	"isCIDRv6":    reflect.ValueOf(IsCIDRv6),
-	"isDNSName":   reflect.ValueOf(IsDNSName),
-	"isDataURI":   reflect.ValueOf(IsDataURI),
-	"isEmpty":     reflect.ValueOf(IsEmpty),
-	"isHexColor":  reflect.ValueOf(IsHexColor),
-	"isISBN10":    reflect.ValueOf(IsISBN10),
-	"isISBN13":    reflect.ValueOf(IsISBN13),
-	"isJSON":      reflect.ValueOf(IsJSON),
-	"isLatitude":  reflect.ValueOf(IsLatitude),
-	"isLongitude": reflect.ValueOf(IsLongitude),
-	"isMAC":       reflect.ValueOf(IsMAC),
-	"isMultiByte": reflect.ValueOf(IsMultiByte),
-	"isNumber":    reflect.ValueOf(IsNumber),
-	"isNumeric":   reflect.ValueOf(IsNumeric),
-	"isCnMobile":  reflect.ValueOf(IsCnMobile),
+	"isIP":          reflect.ValueOf(IsIP),
+	"isIPv4":        reflect.ValueOf(IsIPv4),
+	"isIPv6":        reflect.ValueOf(IsIPv6),
+	"isEmail":       reflect.ValueOf(IsEmail),
+	"isASCII":       reflect.ValueOf(IsASCII),
+	"isAlpha":       reflect.ValueOf(IsAlpha),
+	"isAlphaNum":    reflect.ValueOf(IsAlphaNum),
+	"isAlphaDash":   reflect.ValueOf(IsAlphaDash),
+	"isBase64":      reflect.ValueOf(IsBase64),
+	"isCIDR":        reflect.ValueOf(IsCIDR),
+	"isCIDRv4":      reflect.ValueOf(IsCIDRv4),
+	"isCIDRv6":      reflect.ValueOf(IsCIDRv6),
+	"isDNSName":     reflect.ValueOf(IsDNSName),
+	"isDataURI":     reflect.ValueOf(IsDataURI),
+	"isMediaType":   reflect.ValueOf(IsMediaType),
+	"isEmpty":       reflect.ValueOf(IsEmpty),
+	"isHexColor":    reflect.ValueOf(IsHexColor),
+	"isISBN10":      reflect.ValueOf(IsISBN10),
+	"isISBN13":      reflect.ValueOf(IsISBN13),
+	"isJSON":        reflect.ValueOf(IsJSON),
+	"isJSONPointer": reflect.ValueOf(IsJSONPointer),
+	"isJSONPath":    reflect.ValueOf(IsJSONPath),
+	"isYAML":        reflect.ValueOf(IsYAML),
+	"isHTMLValid":   reflect.ValueOf(IsHTMLValid),
+	"isJWT":         reflect.ValueOf(IsJWT),
+	"isRegex":       reflect.ValueOf(IsRegex),
+	// iso codes
+	"isCountryCode":  reflect.ValueOf(IsCountryCode),
+	"isCurrencyCode": reflect.ValueOf(IsCurrencyCode),
+	"isLanguageCode": reflect.ValueOf(IsLanguageCode),
+	"isLatitude":     reflect.ValueOf(IsLatitude),
+	"isLongitude":    reflect.ValueOf(IsLongitude),
+	"isMAC":          reflect.ValueOf(IsMAC),
+	"isMultiByte":    reflect.ValueOf(IsMultiByte),
+	"isNumber":       reflect.ValueOf(IsNumber),
+	"isNumeric":      reflect.ValueOf(IsNumeric),
+	"isCnMobile":     reflect.ValueOf(IsCnMobile),
+	"isE164":         reflect.ValueOf(IsE164),
 	// ---
-	"isStringNumber":   reflect.ValueOf(IsStringNumber),
-	"hasWhitespace":    reflect.ValueOf(HasWhitespace),
-	"isHexadecimal":    reflect.ValueOf(IsHexadecimal),
-	"isPrintableASCII": reflect.ValueOf(IsPrintableASCII),
+	"isStringNumber":     reflect.ValueOf(IsStringNumber),
+	"hasWhitespace":      reflect.ValueOf(HasWhitespace),
+	"noSurroundingSpace": reflect.ValueOf(NoSurroundingSpace),
+	"noLeadingZero":      reflect.ValueOf(NoLeadingZero),
+	"isHexadecimal":      reflect.ValueOf(IsHexadecimal),
+	"isPrintableASCII":   reflect.ValueOf(IsPrintableASCII),
+	"isPrintable":        reflect.ValueOf(IsPrintable),
+	"hasPublicSuffix":    reflect.ValueOf(HasPublicSuffix),
 	// ---
 	"isRGBColor": reflect.ValueOf(IsRGBColor),
+	"isColor":    reflect.ValueOf(IsColor),
 	"isURL":      reflect.ValueOf(IsURL),
 	"isFullURL":  reflect.ValueOf(IsFullURL),
 	"isUUID":     reflect.ValueOf(IsUUID),
@@ -110,13 +160,17 @@ var validatorValues = map[string]reflect.Value{
 	"isUUID4":    reflect.ValueOf(IsUUID4),
 	"isUUID5":    reflect.ValueOf(IsUUID5),
 	// file system
-	"pathExists": reflect.ValueOf(PathExists),
-	"isDirPath":  reflect.ValueOf(IsDirPath),
-	"isFilePath": reflect.ValueOf(IsFilePath),
-	"isUnixPath": reflect.ValueOf(IsUnixPath),
-	"isWinPath":  reflect.ValueOf(IsWinPath),
+	"pathExists":  reflect.ValueOf(PathExists),
+	"isDirPath":   reflect.ValueOf(IsDirPath),
+	"isFilePath":  reflect.ValueOf(IsFilePath),
+	"isValidPath": reflect.ValueOf(IsValidPath),
+	"isUnixPath":  reflect.ValueOf(IsUnixPath),
+	"isWinPath":   reflect.ValueOf(IsWinPath),
 	// date check
 	"isDate":     reflect.ValueOf(IsDate),
+	"dateTime":   reflect.ValueOf(DateTime),
+	"format":     reflect.ValueOf(IsFormat),
+	"isCron":     reflect.ValueOf(IsCron),
 	"afterDate":  reflect.ValueOf(AfterDate),
 	"beforeDate": reflect.ValueOf(BeforeDate),
 	// ---
@@ -127,9 +181,20 @@ var validatorValues = map[string]reflect.Value{
 // define validator alias name mapping
 var validatorAliases = map[string]string{
 	// alias -> real name
-	"in":     "enum",
-	"not_in": "notIn",
-	"range":  "between",
+	"in":          "enum",
+	"not_in":      "notIn",
+	"in_numbers":  "inNumbers",
+	"index_in":    "indexIn",
+	"not_common":  "notCommon",
+	"not_member":  "notMember",
+	"entropy":     "minEntropy",
+	"range":       "between",
+	"multiple_of": "multipleOf",
+	// number sign
+	"non_negative": "nonNegative",
+	"non_positive": "nonPositive",
+	// whole number
+	"whole_number": "isInteger",
 	// type
 	"int":       "isInt",
 	"integer":   "isInt",
@@ -137,6 +202,17 @@ var validatorAliases = map[string]string{
 	"bool":      "isBool",
 	"boolean":   "isBool",
 	"float":     "isFloat",
+	"int8":      "isInt8",
+	"int16":     "isInt16",
+	"int32":     "isInt32",
+	"int64":     "isInt64",
+	"uint8":     "isUint8",
+	"uint16":    "isUint16",
+	"uint32":    "isUint32",
+	"uint64":    "isUint64",
+	"float32":   "isFloat32",
+	"float64":   "isFloat64",
+	"num_type":  "numType",
 	"map":       "isMap",
 	"ints":      "isInts", // []int
 	"int_slice": "isInts",
@@ -150,6 +226,7 @@ var validatorAliases = map[string]string{
 	"list":      "isArray",
 	"array":     "isArray",
 	"slice":     "isSlice",
+	"sorted":    "isSorted",
 	// val
 	"regex":  "regexp",
 	"eq":     "isEqual",
@@ -182,6 +259,7 @@ var validatorAliases = map[string]string{
 	"maxLen":     "maxLength",
 	"max_len":    "maxLength",
 	"max_length": "maxLength",
+	"bytes_max":  "bytesMax",
 	"minsize":    "minLength",
 	"minSize":    "minLength",
 	"min_size":   "minLength",
@@ -198,6 +276,11 @@ var validatorAliases = map[string]string{
 	"rune_len":    "stringLength",
 	"runeLength":  "stringLength",
 	"rune_length": "stringLength",
+	// contains
+	"contains_value": "containsValue",
+	"allowed_keys":   "allowedKeys",
+	"no_empty_items": "noEmptyItems",
+	"unique_fold":    "uniqueFold",
 	// string contains
 	"string_contains": "stringContains",
 	"str_contains":    "stringContains",
@@ -227,90 +310,114 @@ var validatorAliases = map[string]string{
 	"intString":  "isIntString",
 	"int_string": "isIntString",
 	// ---
-	"stringNum":       "isStringNumber",
-	"string_num":      "isStringNumber",
-	"strNumber":       "isStringNumber",
-	"str_number":      "isStringNumber",
-	"strnum":          "isStringNumber",
-	"strNum":          "isStringNumber",
-	"str_num":         "isStringNumber",
-	"stringNumber":    "isStringNumber",
-	"string_number":   "isStringNumber",
-	"hexadecimal":     "isHexadecimal",
-	"hasWhitespace":   "hasWhitespace",
-	"has_whitespace":  "hasWhitespace",
-	"has_wp":          "hasWhitespace",
-	"printableASCII":  "isPrintableASCII",
-	"printable_ascii": "isPrintableASCII",
-	"printable_ASCII": "isPrintableASCII",
+	"stringNum":            "isStringNumber",
+	"string_num":           "isStringNumber",
+	"strNumber":            "isStringNumber",
+	"str_number":           "isStringNumber",
+	"strnum":               "isStringNumber",
+	"strNum":               "isStringNumber",
+	"str_num":              "isStringNumber",
+	"stringNumber":         "isStringNumber",
+	"string_number":        "isStringNumber",
+	"hexadecimal":          "isHexadecimal",
+	"hasWhitespace":        "hasWhitespace",
+	"has_whitespace":       "hasWhitespace",
+	"has_wp":               "hasWhitespace",
+	"no_surrounding_space": "noSurroundingSpace",
+	"no_leading_zero":      "noLeadingZero",
+	"printableASCII":       "isPrintableASCII",
+	"printable_ascii":      "isPrintableASCII",
+	"printable_ASCII":      "isPrintableASCII",
+	"printable":            "isPrintable",
 	// ---
-	"ascii":      "isASCII",
-	"ASCII":      "isASCII",
-	"alpha":      "isAlpha",
-	"alphaNum":   "isAlphaNum",
-	"alpha_num":  "isAlphaNum",
-	"alphaDash":  "isAlphaDash",
-	"alpha_dash": "isAlphaDash",
-	"base64":     "isBase64",
-	"cidr":       "isCIDR",
-	"CIDR":       "isCIDR",
-	"CIDRv4":     "isCIDRv4",
-	"cidrv4":     "isCIDRv4",
-	"cidr_v4":    "isCIDRv4",
-	"cidrv6":     "isCIDRv6",
-	"CIDRv6":     "isCIDRv6",
-	"cidr_v6":    "isCIDRv6",
-	"dnsname":    "isDNSName",
-	"dnsName":    "isDNSName",
-	"dns_name":   "isDNSName",
-	"DNSName":    "isDNSName",
-	"datauri":    "isDataURI",
-	"dataURI":    "isDataURI",
-	"data_URI":   "isDataURI",
-	"data_uri":   "isDataURI",
-	"empty":      "isEmpty",
-	"HEXColor":   "isHexColor",
-	"hexcolor":   "isHexColor",
-	"hexColor":   "isHexColor",
-	"hex_color":  "isHexColor",
-	"isbn10":     "isISBN10",
-	"ISBN10":     "isISBN10",
-	"isbn13":     "isISBN13",
-	"ISBN13":     "isISBN13",
-	"json":       "isJSON",
-	"Json":       "isJSON",
-	"JSON":       "isJSON",
-	"lat":        "isLatitude",
-	"latitude":   "isLatitude",
-	"lon":        "isLongitude",
-	"longitude":  "isLongitude",
-	"mac":        "isMAC",
-	"MAC":        "isMAC",
-	"multiByte":  "isMultiByte",
-	"num":        "isNumber",
-	"number":     "isNumber",
-	"numeric":    "isNumeric",
-	"rgbcolor":   "isRGBColor",
-	"rgbColor":   "isRGBColor",
-	"rgb_color":  "isRGBColor",
-	"RGBColor":   "isRGBColor",
-	"RGB_color":  "isRGBColor",
-	"url":        "isURL",
-	"URL":        "isURL",
-	"fullURL":    "isFullURL",
-	"fullUrl":    "isFullURL",
-	"fullurl":    "isFullURL",
-	"full_url":   "isFullURL",
-	"uuid":       "isUUID",
-	"UUID":       "isUUID",
-	"uuid3":      "isUUID3",
-	"UUID3":      "isUUID3",
-	"uuid4":      "isUUID4",
-	"UUID4":      "isUUID4",
-	"uuid5":      "isUUID5",
-	"UUID5":      "isUUID5",
-	"cnMobile":   "isCnMobile",
-	"cn_mobile":  "isCnMobile",
+	"ascii":        "isASCII",
+	"ASCII":        "isASCII",
+	"alpha":        "isAlpha",
+	"alphaNum":     "isAlphaNum",
+	"alpha_num":    "isAlphaNum",
+	"alphaDash":    "isAlphaDash",
+	"alpha_dash":   "isAlphaDash",
+	"base64":       "isBase64",
+	"cidr":         "isCIDR",
+	"CIDR":         "isCIDR",
+	"CIDRv4":       "isCIDRv4",
+	"cidrv4":       "isCIDRv4",
+	"cidr_v4":      "isCIDRv4",
+	"cidrv6":       "isCIDRv6",
+	"CIDRv6":       "isCIDRv6",
+	"cidr_v6":      "isCIDRv6",
+	"dnsname":      "isDNSName",
+	"dnsName":      "isDNSName",
+	"dns_name":     "isDNSName",
+	"DNSName":      "isDNSName",
+	"publicsuffix": "hasPublicSuffix",
+	"publicSuffix": "hasPublicSuffix",
+	"datauri":      "isDataURI",
+	"dataURI":      "isDataURI",
+	"data_URI":     "isDataURI",
+	"data_uri":     "isDataURI",
+	"mediatype":    "isMediaType",
+	"mediaType":    "isMediaType",
+	"media_type":   "isMediaType",
+	"empty":        "isEmpty",
+	"HEXColor":     "isHexColor",
+	"hexcolor":     "isHexColor",
+	"hexColor":     "isHexColor",
+	"hex_color":    "isHexColor",
+	"isbn10":       "isISBN10",
+	"ISBN10":       "isISBN10",
+	"isbn13":       "isISBN13",
+	"ISBN13":       "isISBN13",
+	"json":         "isJSON",
+	"Json":         "isJSON",
+	"JSON":         "isJSON",
+	"jsonpointer":  "isJSONPointer",
+	"json_pointer": "isJSONPointer",
+	"jsonpath":     "isJSONPath",
+	"json_path":    "isJSONPath",
+	"yaml":         "isYAML",
+	"YAML":         "isYAML",
+	"htmlValid":    "isHTMLValid",
+	"html_valid":   "isHTMLValid",
+	"jwt":          "isJWT",
+	"JWT":          "isJWT",
+	"is_regex":     "isRegex",
+	"country":      "isCountryCode",
+	"currency":     "isCurrencyCode",
+	"lang":         "isLanguageCode",
+	"lat":          "isLatitude",
+	"latitude":     "isLatitude",
+	"lon":          "isLongitude",
+	"longitude":    "isLongitude",
+	"mac":          "isMAC",
+	"MAC":          "isMAC",
+	"multiByte":    "isMultiByte",
+	"num":          "isNumber",
+	"number":       "isNumber",
+	"numeric":      "isNumeric",
+	"rgbcolor":     "isRGBColor",
+	"rgbColor":     "isRGBColor",
+	"rgb_color":    "isRGBColor",
+	"RGBColor":     "isRGBColor",
+	"RGB_color":    "isRGBColor",
+	"color":        "isColor",
+	"url":          "isURL",
+	"URL":          "isURL",
+	"fullURL":      "isFullURL",
+	"fullUrl":      "isFullURL",
+	"fullurl":      "isFullURL",
+	"full_url":     "isFullURL",
+	"uuid":         "isUUID",
+	"UUID":         "isUUID",
+	"uuid3":        "isUUID3",
+	"UUID3":        "isUUID3",
+	"uuid4":        "isUUID4",
+	"UUID4":        "isUUID4",
+	"uuid5":        "isUUID5",
+	"UUID5":        "isUUID5",
+	"cnMobile":     "isCnMobile",
+	"cn_mobile":    "isCnMobile",
+	"e164":         "isE164",
 	// file system
 	"path_exists": "pathExists",
 	"pathExist":   "pathExists",
@@ -324,37 +431,49 @@ var validatorAliases = map[string]string{
 	"unix_path":   "isUnixPath",
 	"winPath":     "isWinPath",
 	"win_path":    "isWinPath",
+	"valid_path":  "isValidPath",
+	"path_syntax": "isValidPath",
 	// date
-	"date":     "isDate",
-	"gtDate":   "afterDate",
-	"gt_date":  "afterDate",
-	"ltDate":   "beforeDate",
-	"lt_date":  "beforeDate",
-	"gteDate":  "afterOrEqualDate",
-	"gte_date": "afterOrEqualDate",
-	"lteDate":  "beforeOrEqualDate",
-	"lte_date": "beforeOrEqualDate",
+	"date":        "isDate",
+	"datetime":    "dateTime",
+	"cron":        "isCron",
+	"gtDate":      "afterDate",
+	"gt_date":     "afterDate",
+	"ltDate":      "beforeDate",
+	"lt_date":     "beforeDate",
+	"gteDate":     "afterOrEqualDate",
+	"gte_date":    "afterOrEqualDate",
+	"lteDate":     "beforeOrEqualDate",
+	"lte_date":    "beforeOrEqualDate",
+	"min_age":     "minAge",
+	"max_age":     "maxAge",
+	"within_days": "withinDays",
+	"min_days":    "minDays",
 	// uploaded file
-	"img":          "isImage",
-	"image":        "isImage",
-	"upload_image": "isImage",
-	"file":         "isFile",
-	"upload_file":  "isFile",
-	"mime":         "inMimeTypes",
-	"mimes":        "inMimeTypes",
-	"mimeType":     "inMimeTypes",
-	"mime_type":    "inMimeTypes",
-	"mimeTypes":    "inMimeTypes",
-	"mime_types":   "inMimeTypes",
+	"img":              "isImage",
+	"image":            "isImage",
+	"upload_image":     "isImage",
+	"file":             "isFile",
+	"upload_file":      "isFile",
+	"mime":             "inMimeTypes",
+	"mimes":            "inMimeTypes",
+	"mimeType":         "inMimeTypes",
+	"mime_type":        "inMimeTypes",
+	"mimeTypes":        "inMimeTypes",
+	"mime_types":       "inMimeTypes",
+	"dimensions":       "imageDimensions",
+	"image_dimensions": "imageDimensions",
 	// field compare
-	"eq_field":  "eqField",
-	"ne_field":  "neField",
-	"neqField":  "neField",
-	"neq_field": "neField",
-	"gt_field":  "gtField",
-	"gte_field": "gteField",
-	"lt_field":  "ltField",
-	"lte_field": "lteField",
+	"eq_field":     "eqField",
+	"ne_field":     "neField",
+	"neqField":     "neField",
+	"neq_field":    "neField",
+	"gt_field":     "gtField",
+	"gte_field":    "gteField",
+	"lt_field":     "ltField",
+	"lte_field":    "lteField",
+	"len_eq_field": "lenEqField",
+	"same_len":     "sameLen",
 	// requiredXXX
 	"required_if":          "requiredIf",
 	"required_unless":      "requiredUnless",
@@ -362,6 +481,13 @@ var validatorAliases = map[string]string{
 	"required_with_all":    "requiredWithAll",
 	"required_without":     "requiredWithout",
 	"required_without_all": "requiredWithoutAll",
+	"atLeast":              "requiredAtLeast",
+	"at_least":             "requiredAtLeast",
+	"together":             "requiredTogether",
+	"required_together":    "requiredTogether",
+	"required_keys":        "requiredKeys",
+	// forbidden
+	"forbidden_if": "forbiddenIf",
 	// other
 	"not_contains": "notContains",
 }
@@ -1,7 +1,16 @@
 package validate
 
 import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"reflect"
+	"strconv"
 	"strings"
+
+	"github.com/gookit/goutil/arrutil"
+	"github.com/gookit/goutil/strutil"
+	"gopkg.in/yaml.v3"
 )
 
 // Rules definition
@@ -133,6 +142,12 @@ func (r *Rule) Fields() []string {
 }
 
 func (r *Rule) errorMessage(field, validator string, v *Validation) (msg string) {
+	// the message args recorded by the validator
+	ma, hasArgs := v.msgArgs[field]
+	if hasArgs {
+		delete(v.msgArgs, field)
+	}
+
 	if r.messages != nil {
 		var ok bool
 		// use the full key. "field.validator"
@@ -150,6 +165,18 @@ func (r *Rule) errorMessage(field, validator string, v *Validation) (msg string)
 		return r.message
 	}
 
+	// the validator is routed to another one by the args. eg: "filepath:abs" -> "isValidPath"
+	if r.realName != ValidatorName(validator) && !v.trans.HasMessage(validator) {
+		validator = r.realName
+	}
+
+	if hasArgs {
+		if ma.key != "" {
+			validator = ma.key
+		}
+		return v.trans.Message(validator, field, ma.args...)
+	}
+
 	// built in error messages
 	return v.trans.Message(validator, field, r.arguments...)
 }
@@ -198,6 +225,23 @@ func (v *Validation) StringRule(field, rule string, filterRule ...string) *Valid
 			// some special validator. need merge args to one.
 			case "enum", "notIn":
 				v.AddRule(field, validator, parseArgString(list[1]))
+			// eg 'datetime:2006-01-02;15:04:05' dont split the layouts by ":"
+			case "dateTime":
+				v.AddRule(field, validator, strings.Join(list[1:], ":"))
+			// eg 'glob:*.txt' or 'glob:*.txt,i'. dont split the pattern, only check the last flag
+			case "glob":
+				if pattern, flag, ok := cutLast(list[1], ","); ok && flag == "i" {
+					v.AddRule(field, validator, pattern, flag)
+				} else {
+					v.AddRule(field, validator, list[1])
+				}
+			// eg 'within_days:30:start_date' args is "30", "start_date"
+			case "withinDays", "minDays":
+				v.AddRule(field, validator, strings2Args(list[1:])...)
+			// eg 'at_least:2:a,b,c' args is "2", "a", "b", "c"
+			case "requiredAtLeast":
+				args := parseArgString(strings.Join(list[1:], ","))
+				v.AddRule(field, validator, strings2Args(args)...)
 			default:
 				args := parseArgString(list[1])
 				v.AddRule(field, validator, strings2Args(args)...)
@@ -228,6 +272,31 @@ func (v *Validation) StringRules(mp MS) *Validation {
 	return v
 }
 
+// LoadRules parse the rules config data to a field rules map.
+// the data is a map of "field -> rule-string". format allow: json, yaml(yml)
+//
+// Usage:
+//
+//	rules, err := validate.LoadRules([]byte(`{"name": "required|minLen:2"}`), "json")
+//	v.StringRules(rules)
+func LoadRules(data []byte, format string) (MS, error) {
+	rules := make(MS)
+
+	switch strings.ToLower(format) {
+	case "json":
+		if err := json.Unmarshal(data, &rules); err != nil {
+			return nil, err
+		}
+	case "yaml", "yml":
+		if err := yaml.Unmarshal(data, &rules); err != nil {
+			return nil, fmt.Errorf("validate: invalid yaml rules: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("validate: unsupported rules format '%s'", format)
+	}
+	return rules, nil
+}
+
 // ConfigRules add multi rules by string map. alias of StringRules()
 //
 // Usage:
@@ -243,6 +312,56 @@ func (v *Validation) ConfigRules(mp MS) *Validation {
 	return v
 }
 
+// RuleSet a reusable set of field rules, build once and share across validations.
+//
+// Usage:
+//
+//	rs := validate.NewRuleSet().
+//		Add("email", "required|email").
+//		Add("name", "required|minLen:2", "trim")
+//	v.UseRuleSet(rs)
+type RuleSet struct {
+	items []ruleSetItem
+}
+
+type ruleSetItem struct {
+	field, rule string
+	filters     []string
+}
+
+// NewRuleSet create a new rule set.
+func NewRuleSet() *RuleSet {
+	return &RuleSet{}
+}
+
+// Add field rules by string, the filterRule is optional. see Validation.StringRule
+func (rs *RuleSet) Add(field, rule string, filterRule ...string) *RuleSet {
+	rs.items = append(rs.items, ruleSetItem{field: field, rule: rule, filters: filterRule})
+	return rs
+}
+
+// AddRules add multi field rules by string map.
+func (rs *RuleSet) AddRules(mp MS) *RuleSet {
+	for field, rule := range mp {
+		rs.Add(field, rule)
+	}
+	return rs
+}
+
+// Len get the number of the added rule items
+func (rs *RuleSet) Len() int {
+	return len(rs.items)
+}
+
+// UseRuleSet add the rules from the rule set. the rules are merged with the
+// instance-specific rules: both are kept and checked for the same field.
+func (v *Validation) UseRuleSet(rs *RuleSet) *Validation {
+	for _, item := range rs.items {
+		v.StringRule(item.field, item.rule, item.filters...)
+	}
+	return v
+}
+
 // AddRule for current validation
 //
 // Usage:
@@ -257,11 +376,50 @@ func (v *Validation) AddRule(fields, validator string, args ...any) *Rule {
 func (v *Validation) addOneRule(fields, validator, realName string, args []any) *Rule {
 	rule := NewRule(fields, validator, args...)
 
+	// check the glob pattern on add rule
+	if realName == "glob" && len(args) > 0 {
+		if _, err := path.Match(strutil.QuietString(args[0]), ""); err != nil {
+			panicf("invalid glob pattern '%v' for the field '%s'", args[0], fields)
+		}
+	}
+
+	// "filepath" with the path modes, only check the path syntax. eg: "filepath:abs", "filepath:clean"
+	if realName == "isFilePath" && len(args) > 0 {
+		realName = "isValidPath"
+	}
+
+	// "integer" with a bool arg, check the number has no fractional part. eg: "integer:true"
+	if realName == "isInt" && len(args) == 1 {
+		if arg, isStr := args[0].(string); isStr && (arg == "true" || arg == "false") {
+			realName = "isInteger"
+		}
+	}
+
+	// "lenEq" with a field name, compare with the int value of the field. eg: "lenEq:codeLen"
+	if realName == "length" && len(args) == 1 {
+		if dstField, isStr := args[0].(string); isStr {
+			if _, err := strconv.Atoi(strings.TrimSpace(dstField)); err != nil {
+				realName = "lenEqField"
+			}
+		}
+	}
+
+	// infer the numeric type by the struct field type. eg: `validate:"numType"`
+	if realName == "numType" && len(args) == 0 {
+		if sd, ok := v.data.(*StructData); ok && !strings.ContainsRune(fields, ',') {
+			typ := sd.fieldKind(fields).String()
+			if _, ok := numOfTypes[typ]; !ok {
+				panicf("cannot infer the numeric type of the field '%s'(%s), please set it. eg: numType:int8", fields, typ)
+			}
+			rule.arguments = []any{typ}
+		}
+	}
+
 	// init some settings
 	rule.realName = realName
 	rule.skipEmpty = v.SkipOnEmpty
 	// validator name is not "required"
-	rule.nameNotRequired = !strings.HasPrefix(realName, "required")
+	rule.nameNotRequired = !isPresenceValidator(realName)
 
 	// append
 	v.rules = append(v.rules, rule)
@@ -273,7 +431,7 @@ func (v *Validation) AppendRule(rule *Rule) *Rule {
 	rule.realName = ValidatorName(rule.validator)
 	rule.skipEmpty = v.SkipOnEmpty
 	// validator name is not "required"
-	rule.nameNotRequired = !strings.HasPrefix(rule.realName, "required")
+	rule.nameNotRequired = !isPresenceValidator(rule.realName)
 
 	// append
 	v.rules = append(v.rules, rule)
@@ -286,10 +444,108 @@ func (v *Validation) AppendRules(rules ...*Rule) *Validation {
 		rule.realName = ValidatorName(rule.validator)
 		rule.skipEmpty = v.SkipOnEmpty
 		// validator name is not "required"
-		rule.nameNotRequired = !strings.HasPrefix(rule.realName, "required")
+		rule.nameNotRequired = !isPresenceValidator(rule.realName)
 	}
 
 	// appends
 	v.rules = append(v.rules, rules...)
 	return v
 }
+
+// RulesString export the validation rules as string, grouped by field.
+// one field per line, format is same as StringRule().
+//
+// Output eg:
+//
+//	name: required|minLen:2
+//	age: int|between:1,100
+func (v *Validation) RulesString() string {
+	fields := make([]string, 0, len(v.rules))
+	groups := make(map[string][]string, len(v.rules))
+
+	for _, rule := range v.rules {
+		str := rule.validator
+		if len(rule.arguments) > 0 {
+			args := make([]string, 0, len(rule.arguments))
+			for _, arg := range rule.arguments {
+				args = append(args, ruleArgString(arg))
+			}
+			str += ":" + strings.Join(args, ",")
+		}
+
+		for _, field := range rule.fields {
+			if _, ok := groups[field]; !ok {
+				fields = append(fields, field)
+			}
+			groups[field] = append(groups[field], str)
+		}
+	}
+
+	var sb strings.Builder
+	for i, field := range fields {
+		if i > 0 {
+			sb.WriteByte('\n')
+		}
+		sb.WriteString(field)
+		sb.WriteString(": ")
+		sb.WriteString(strings.Join(groups[field], "|"))
+	}
+	return sb.String()
+}
+
+// convert rule argument to string. eg: []string{"a", "b"} => "a,b"
+func ruleArgString(arg any) string {
+	return strings.Join(ruleArgStrings(arg), ",")
+}
+
+// convert rule argument to strings. eg: []int{1, 2} => []string{"1", "2"}
+func ruleArgStrings(arg any) []string {
+	rv := reflect.ValueOf(arg)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return []string{strutil.QuietString(arg)}
+	}
+
+	ss := make([]string, 0, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		ss = append(ss, strutil.QuietString(rv.Index(i).Interface()))
+	}
+	return ss
+}
+
+// AllowedValues get the allowed values of the field from the "in"(enum) rule.
+// will return ok=false if the field has no "in" rule.
+//
+// Usage:
+//
+//	v.StringRule("status", "in:active,inactive")
+//	vs, ok := v.AllowedValues("status") // []string{"active", "inactive"}, true
+func (v *Validation) AllowedValues(field string) ([]string, bool) {
+	for _, rule := range v.rules {
+		if rule.realName == "enum" && len(rule.arguments) > 0 && arrutil.StringsHas(rule.fields, field) {
+			return ruleArgStrings(rule.arguments[0]), true
+		}
+	}
+	return nil, false
+}
+
+// RuleArgs get the arguments of the field validator rule, the validator can be an alias name.
+// will return ok=false if the field has no the validator rule.
+//
+// NOTE: the string rule args are kept as strings, they are converted to the
+// validator func param types on validate.
+//
+// Usage:
+//
+//	v.StringRule("age", "between:1,10")
+//	args, ok := v.RuleArgs("age", "between") // []any{"1", "10"}, true
+func (v *Validation) RuleArgs(field, validator string) ([]any, bool) {
+	realName := ValidatorName(validator)
+	for _, rule := range v.rules {
+		if rule.realName == realName && arrutil.StringsHas(rule.fields, field) {
+			args := make([]any, len(rule.arguments))
+			copy(args, rule.arguments)
+			return args, true
+		}
+	}
+	return nil, false
+}
@@ -2,6 +2,7 @@ package validate
 
 import (
 	"net/url"
+	"strings"
 	"testing"
 
 	"github.com/gookit/filter"
@@ -113,3 +114,167 @@ func TestRule_SetSkipEmpty(t *testing.T) {
 	is.False(v.Validate())
 	is.Equal("age value must be an integer and mix value is 1", v.Errors.One())
 }
+
+func TestValidation_RulesString(t *testing.T) {
+	is := assert.New(t)
+
+	v := New(M{"name": "inhere", "age": 23, "status": "active"})
+	v.StringRule("name", "required|minLen:2")
+	v.StringRule("age", "int|between:1,100")
+	v.StringRule("status", "in:active,inactive")
+	v.AddRule("name", "string")
+
+	str := v.RulesString()
+	is.Equal("name: required|minLen:2|string\nage: int|between:1,100\nstatus: in:active,inactive", str)
+
+	// round-trip to string rules
+	v2 := New(M{"name": "inhere", "age": 23, "status": "active"})
+	for _, line := range strings.Split(str, "\n") {
+		field, rule, _ := strings.Cut(line, ": ")
+		v2.StringRule(field, rule)
+	}
+	is.Equal(str, v2.RulesString())
+	is.True(v2.Validate())
+
+	is.Equal("", New(M{}).RulesString())
+}
+
+func TestValidation_AllowedValues(t *testing.T) {
+	is := assert.New(t)
+
+	type account struct {
+		Name   string `validate:"required"`
+		Status string `validate:"required|in:active,inactive"`
+	}
+
+	v := Struct(&account{Name: "inhere", Status: "active"})
+	vs, ok := v.AllowedValues("Status")
+	is.True(ok)
+	is.Equal([]string{"active", "inactive"}, vs)
+
+	vs, ok = v.AllowedValues("Name")
+	is.False(ok)
+	is.Nil(vs)
+
+	// add by AddRule
+	v = New(M{"level": 2})
+	v.AddRule("level", "enum", []int{1, 2, 3})
+	vs, ok = v.AllowedValues("level")
+	is.True(ok)
+	is.Equal([]string{"1", "2", "3"}, vs)
+}
+
+func TestValidation_RuleArgs(t *testing.T) {
+	is := assert.New(t)
+
+	v := New(M{"age": 5, "name": "inhere"})
+	v.StringRule("age", "required|between:1,10")
+	v.StringRule("name", "minLen:2")
+
+	args, ok := v.RuleArgs("age", "between")
+	is.True(ok)
+	is.Equal([]any{"1", "10"}, args)
+
+	// by alias name
+	args, ok = v.RuleArgs("name", "min_len")
+	is.True(ok)
+	is.Equal([]any{"2"}, args)
+
+	// no args
+	args, ok = v.RuleArgs("age", "required")
+	is.True(ok)
+	is.Empty(args)
+
+	args, ok = v.RuleArgs("age", "max")
+	is.False(ok)
+	is.Nil(args)
+
+	// the returned args is a copy
+	args, _ = v.RuleArgs("age", "between")
+	args[0] = "3"
+	args, _ = v.RuleArgs("age", "between")
+	is.Equal("1", args[0])
+
+	// add by AddRule
+	v.AddRule("age", "max", 20)
+	args, ok = v.RuleArgs("age", "max")
+	is.True(ok)
+	is.Equal([]any{20}, args)
+}
+
+func TestValidation_UseRuleSet(t *testing.T) {
+	is := assert.New(t)
+
+	rs := NewRuleSet().
+		Add("email", "required|email").
+		Add("name", "required|minLen:2", "trim")
+	is.Equal(2, rs.Len())
+
+	// create user endpoint
+	v1 := New(M{"email": "invalid", "name": "i"})
+	v1.StopOnError = false
+	v1.UseRuleSet(rs)
+	is.False(v1.Validate())
+	is.True(v1.Errors.HasField("email"))
+	is.True(v1.Errors.HasField("name"))
+
+	// update user endpoint, merge with the instance rules
+	v2 := New(M{"email": "some@example.com", "name": "inhere", "age": 3})
+	v2.StopOnError = false
+	v2.UseRuleSet(rs)
+	v2.StringRule("name", "maxLen:5")
+	v2.StringRule("age", "min:18")
+	is.False(v2.Validate())
+	is.False(v2.Errors.HasField("email"))
+	is.Equal("name max length is 5", v2.Errors.FieldOne("name"))
+	is.True(v2.Errors.HasField("age"))
+
+	// the rule set is not changed by the validations
+	is.Equal(2, rs.Len())
+}
+
+func TestLoadRules(t *testing.T) {
+	is := assert.New(t)
+
+	// json
+	rules, err := LoadRules([]byte(`{
+	"name": "required|minLen:2",
+	"age": "required|int|min:1"
+}`), "json")
+	is.NoErr(err)
+	is.Equal(MS{"name": "required|minLen:2", "age": "required|int|min:1"}, rules)
+
+	v := New(M{"name": "inhere", "age": 23}).StringRules(rules)
+	is.True(v.Validate())
+
+	// yaml
+	rules, err = LoadRules([]byte(`
+# user rules
+name: required|minLen:2
+age: required|int|min:1 # inline comment
+code: 'regex:^\d{4}$'
+status: "in:active,inactive" # quoted with comment
+`), "yaml")
+	is.NoErr(err)
+	is.Equal(MS{
+		"name":   "required|minLen:2",
+		"age":    "required|int|min:1",
+		"code":   `regex:^\d{4}$`,
+		"status": "in:active,inactive",
+	}, rules)
+
+	v = New(M{"name": "inhere", "age": 23, "code": "12a4", "status": "active"}).StringRules(rules)
+	is.False(v.Validate())
+	is.True(v.Errors.HasField("code"))
+
+	// invalid
+	_, err = LoadRules([]byte(`{"name": `), "json")
+	is.Err(err)
+	// nested data is not a rule string
+	_, err = LoadRules([]byte("user:\n  name: required"), "yml")
+	is.ErrSubMsg(err, "validate: invalid yaml rules")
+	_, err = LoadRules([]byte("name: [required"), "yaml")
+	is.Err(err)
+	_, err = LoadRules([]byte(`name = "required"`), "toml")
+	is.ErrMsg(err, "validate: unsupported rules format 'toml'")
+}
@@ -22,6 +22,9 @@ var nilObj = NilObject{}
 // init a reflect nil value
 var nilRVal = reflect.ValueOf(nilObj)
 
+// the reflect type of *Validation. see funcMeta.withValidation
+var validationType = reflect.TypeOf((*Validation)(nil))
+
 // NilValue TODO a reflect nil value, use for instead of nilRVal
 var NilValue = reflect.Zero(reflect.TypeOf((*any)(nil)).Elem())
 
@@ -69,6 +72,14 @@ func parseArgString(argStr string) (ss []string) {
 	return stringSplit(argStr, ",")
 }
 
+// cut the string by the last sep. eg: "a,b,c" => "a,b", "c"
+func cutLast(s, sep string) (before, after string, found bool) {
+	if i := strings.LastIndex(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+	return s, "", false
+}
+
 // TODO strutil.Split()
 func stringSplit(str, sep string) (ss []string) {
 	str = strings.TrimSpace(str)
@@ -243,6 +254,20 @@ func valueCompare(srcVal, dstVal any, op string) (ok bool) {
 	return mathutil.Compare(srcVal, dstVal, op)
 }
 
+// convert the int(X), uint(X), float(X) value to float64, for compare the numbers of different type.
+func numberAsFloat(val any) (float64, bool) {
+	rv := reflect.Indirect(reflect.ValueOf(val))
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	}
+	return 0, false
+}
+
 // getVariadicKind name.
 //
 // usage:
@@ -284,6 +309,19 @@ func convToBasicType(val any) (value any, err error) {
 	return
 }
 
+// clone the map, returns nil on the map is nil.
+func cloneMap[K comparable, V any](m map[K]V) map[K]V {
+	if m == nil {
+		return nil
+	}
+
+	newMap := make(map[K]V, len(m))
+	for k, v := range m {
+		newMap[k] = v
+	}
+	return newMap
+}
+
 func panicf(format string, args ...any) {
 	panic("validate: " + fmt.Sprintf(format, args...))
 }
@@ -8,6 +8,7 @@ package validate
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
@@ -108,6 +109,11 @@ type GlobalOption struct {
 	//
 	// default: false
 	ValidatePrivateFields bool
+	// MaxDepth the max nesting depth for collect rules from the sub-struct, array and map elements.
+	// on exceeded, will record a "_validate" error instead of continue.
+	//
+	// default: 32
+	MaxDepth int
 }
 
 // global options
@@ -141,6 +147,7 @@ func newGlobalOption() *GlobalOption {
 		MessageTag: messageTag,
 		// tag name in struct tags
 		ValidateTag: validateTag,
+		MaxDepth:    defaultMaxDepth,
 	}
 }
 
@@ -164,7 +171,8 @@ func newValidation(data DataFace) *Validation {
 
 func newEmpty() *Validation {
 	v := &Validation{
-		Errors: make(Errors),
+		Errors:   make(Errors),
+		Warnings: make(Errors),
 		// create message translator
 		// trans: StdTranslator,
 		trans: NewTranslator(),
@@ -174,6 +182,9 @@ func newEmpty() *Validation {
 		validators: make(map[string]int8, 16),
 		// filtered data
 		filteredData: make(map[string]any),
+		coercedData:  make(map[string]any),
+		parsedTimes:  make(map[string]ParsedTime),
+		typedData:    make(map[string]any),
 		// default config
 		StopOnError: gOpt.StopOnError,
 		SkipOnEmpty: gOpt.SkipOnEmpty,
@@ -188,17 +199,35 @@ func newEmpty() *Validation {
 		"requiredWithAll":    reflect.ValueOf(v.RequiredWithAll),
 		"requiredWithout":    reflect.ValueOf(v.RequiredWithout),
 		"requiredWithoutAll": reflect.ValueOf(v.RequiredWithoutAll),
+		"requiredAtLeast":    reflect.ValueOf(v.RequiredAtLeast),
+		"requiredTogether":   reflect.ValueOf(v.RequiredTogether),
+		"requiredKeys":       reflect.ValueOf(v.RequiredKeys),
+		// forbidden
+		"forbidden":   reflect.ValueOf(v.Forbidden),
+		"forbiddenIf": reflect.ValueOf(v.ForbiddenIf),
 		// field compare
-		"eqField":  reflect.ValueOf(v.EqField),
-		"neField":  reflect.ValueOf(v.NeField),
-		"gtField":  reflect.ValueOf(v.GtField),
-		"gteField": reflect.ValueOf(v.GteField),
-		"ltField":  reflect.ValueOf(v.LtField),
-		"lteField": reflect.ValueOf(v.LteField),
+		"eqField":    reflect.ValueOf(v.EqField),
+		"neField":    reflect.ValueOf(v.NeField),
+		"gtField":    reflect.ValueOf(v.GtField),
+		"gteField":   reflect.ValueOf(v.GteField),
+		"ltField":    reflect.ValueOf(v.LtField),
+		"lteField":   reflect.ValueOf(v.LteField),
+		"lenEqField": reflect.ValueOf(v.LenEqField),
+		"sameLen":    reflect.ValueOf(v.SameLen),
+		// signature check
+		"hmac": reflect.ValueOf(v.HMAC),
+		// date age check
+		"minAge": reflect.ValueOf(v.MinAge),
+		"maxAge": reflect.ValueOf(v.MaxAge),
+		// date span check
+		"withinDays": reflect.ValueOf(v.WithinDays),
+		"minDays":    reflect.ValueOf(v.MinDays),
 		// file upload check
 		"isFile":      reflect.ValueOf(v.IsFormFile),
 		"isImage":     reflect.ValueOf(v.IsFormImage),
 		"inMimeTypes": reflect.ValueOf(v.InMimeTypes),
+		// image dimensions check
+		"imageDimensions": reflect.ValueOf(v.ImageDimensions),
 	}
 
 	v.validatorMetas = make(map[string]*funcMeta, len(ctxValidatorMap))
@@ -221,6 +250,7 @@ func newEmpty() *Validation {
 // data type support:
 //   - DataFace
 //   - M/map[string]any
+//   - map[string]string
 //   - SValues/url.Values/map[string][]string
 //   - struct ptr
 func New(data any, scene ...string) *Validation {
@@ -231,6 +261,8 @@ func New(data any, scene ...string) *Validation {
 		return FromMap(td).Create().SetScene(scene...)
 	case map[string]any:
 		return FromMap(td).Create().SetScene(scene...)
+	case map[string]string:
+		return FromStringMap(td).Create().SetScene(scene...)
 	case SValues:
 		return FromURLValues(url.Values(td)).Create().SetScene(scene...)
 	case url.Values:
@@ -258,6 +290,20 @@ func Map(m map[string]any, scene ...string) *Validation {
 // 	return FromMap(m).Create().StringRules(rules)
 // }
 
+// FromValues create validation from string values map.
+// such as the path params from router: chi, gorilla/mux
+//
+// Usage:
+//
+//	// gorilla/mux
+//	v := validate.FromValues(mux.Vars(r))
+//	// chi
+//	v := validate.FromValues(map[string]string{"id": chi.URLParam(r, "id")})
+//	v.StringRule("id", "required|isUint")
+func FromValues(m map[string]string, scene ...string) *Validation {
+	return FromStringMap(m).Create().SetScene(scene...)
+}
+
 // JSON create validation from JSON string.
 func JSON(s string, scene ...string) *Validation {
 	return mustNewValidation(FromJSON(s)).SetScene(scene...)
@@ -273,6 +319,181 @@ func Request(r *http.Request) *Validation {
 	return mustNewValidation(FromRequest(r))
 }
 
+// Template an immutable snapshot of the rules and settings of a Validation. see Validation.Prepare
+//
+// It is safe for concurrent use, create the validating instance by NewFrom().
+type Template struct {
+	v *Validation
+}
+
+// Prepare create an immutable template from the rules and settings of the Validation.
+// the later changes on the Validation will not affect the template.
+//
+// Usage:
+//
+//	tpl := validate.NewEmpty().StringRules(rules).Prepare()
+//	// in each request goroutine
+//	v := validate.NewFrom(tpl, data)
+//	ok := v.Validate()
+func (v *Validation) Prepare() *Template {
+	tv := newEmpty()
+	v.copyConfigTo(tv)
+	return &Template{v: tv}
+}
+
+// NewFrom create a fresh Validation instance by the template and data. data type allow same as New()
+//
+// The instance has its own validate result and rule arguments,
+// so it is safe to create and validate the instances concurrently from one template.
+func NewFrom(t *Template, data any, scene ...string) *Validation {
+	v := New(data)
+	t.v.copyConfigTo(v)
+
+	if len(scene) > 0 {
+		v.SetScene(scene...)
+	}
+	return v
+}
+
+// copy the rules and settings to the dst validation. the dst data, validate
+// result, context validators and the rules from data(eg: struct tags) are kept.
+func (v *Validation) copyConfigTo(dst *Validation) {
+	data, trans := dst.data, dst.trans
+	validators, metas := dst.validators, dst.validatorMetas
+	rules, filterRules := dst.rules, dst.filterRules
+
+	// copy the settings
+	*dst = *v
+	dst.data = data
+	dst.ResetResult()
+	dst.sceneFields, dst.excludeFields = nil, nil
+	dst.parent, dst.baseline = nil, nil
+
+	// the rule arguments will be converted on validate, so need copy them.
+	dst.rules = make([]*Rule, 0, len(v.rules)+len(rules))
+	for _, rule := range v.rules {
+		newRule := *rule
+		newRule.arguments = append([]any(nil), rule.arguments...)
+		dst.rules = append(dst.rules, &newRule)
+	}
+	dst.rules = append(dst.rules, rules...)
+	dst.filterRules = append(append([]*FilterRule(nil), v.filterRules...), filterRules...)
+
+	// the context validators must be bound to dst, only copy the custom validators.
+	for name, typ := range v.validators {
+		if typ == validatorTypeCustom {
+			validators[name] = typ
+			metas[name] = v.validatorMetas[name]
+		}
+	}
+	dst.validators, dst.validatorMetas = validators, metas
+
+	trans.AddMessages(v.trans.messages)
+	trans.AddLabelMap(v.trans.labelMap)
+	trans.AddFieldMap(v.trans.fieldMap)
+	dst.trans = trans
+
+	// the config maps can be modified on the instance.
+	dst.defValues = cloneMap(v.defValues)
+	dst.valueTransformers = cloneMap(v.valueTransformers)
+	dst.fieldAliases = cloneMap(v.fieldAliases)
+	dst.disabledValidators = cloneMap(v.disabledValidators)
+	dst.warnValidators = cloneMap(v.warnValidators)
+	dst.sceneParents = cloneMap(v.sceneParents)
+	dst.filterValues = cloneMap(v.filterValues)
+}
+
+// ValidateSlice validate each item of the slice. item type allow same as New()
+//
+// Will returns Validation for each item, and ok=True if all items passed.
+// Use SliceErrors() to collect errors with the item index. eg: "[2].email"
+//
+// Usage:
+//
+//	vs, ok := validate.ValidateSlice(users)
+//	// with extra rules
+//	vs, ok := validate.ValidateSlice(users, validate.MS{"email": "required|email"})
+//	if !ok {
+//		fmt.Println(validate.SliceErrors(vs))
+//	}
+func ValidateSlice(items any, rules ...MS) ([]*Validation, bool) {
+	rv := reflect.Indirect(reflect.ValueOf(items))
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		panicf("ValidateSlice: the items must be an array or slice, but got %T", items)
+	}
+
+	ok := true
+	vs := make([]*Validation, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		ev := rv.Index(i)
+		// use struct ptr, allow to update source value.
+		if ev.Kind() == reflect.Struct && ev.CanAddr() {
+			ev = ev.Addr()
+		}
+
+		v := New(ev.Interface())
+		for _, mp := range rules {
+			v.StringRules(mp)
+		}
+
+		if !v.Validate() {
+			ok = false
+		}
+		vs[i] = v
+	}
+	return vs, ok
+}
+
+// SliceErrors collect errors from the ValidateSlice() results.
+// The error field key will add item index as prefix. eg: "[2].email"
+func SliceErrors(vs []*Validation) Errors {
+	es := make(Errors)
+	for i, v := range vs {
+		for field, fe := range v.Errors {
+			es[fmt.Sprintf("[%d].%s", i, field)] = fe
+		}
+	}
+	return es
+}
+
+// ValidateCSV validate each data row of the CSV records, the first record is the header row.
+// the header columns will be used as the field names for the rules.
+//
+// Will returns Validation for each data row(not contains the header), and ok=True if all rows passed.
+// Use SliceErrors() to collect errors with the row index and column name. eg: "[1].email"
+//
+// Usage:
+//
+//	records, _ := csv.NewReader(file).ReadAll()
+//	vs, ok := validate.ValidateCSV(records, validate.MS{"email": "required|email"})
+//	if !ok {
+//		fmt.Println(validate.SliceErrors(vs))
+//	}
+func ValidateCSV(records [][]string, rules MS) ([]*Validation, bool) {
+	if len(records) == 0 {
+		return nil, true
+	}
+
+	ok := true
+	header := records[0]
+	vs := make([]*Validation, 0, len(records)-1)
+	for _, row := range records[1:] {
+		mp := make(map[string]string, len(header))
+		for i, col := range header {
+			if i < len(row) {
+				mp[strings.TrimSpace(col)] = row[i]
+			}
+		}
+
+		v := FromStringMap(mp).Create().StringRules(rules)
+		if !v.Validate() {
+			ok = false
+		}
+		vs = append(vs, v)
+	}
+	return vs, ok
+}
+
 func mustNewValidation(d DataFace, err error) *Validation {
 	if d == nil {
 		if err != nil {
@@ -298,11 +519,48 @@ func FromMap(m map[string]any) *MapData {
 	return data
 }
 
+// FromStringMap build data instance from map[string]string.
+// it is faster than FromMap() on the values are all string.
+//
+// The keys are used as is, so the env var style names are also supported. eg:
+//
+//	v := validate.New(envMap)
+//	v.StringRule("DATABASE_URL", "required|fullUrl")
+func FromStringMap(m map[string]string) *StringMapData {
+	if m == nil {
+		m = make(map[string]string)
+	}
+
+	d := &StringMapData{Map: m}
+	d.boxValues()
+	return d
+}
+
 // FromJSON string build data instance.
 func FromJSON(s string) (*MapData, error) {
 	return FromJSONBytes([]byte(s))
 }
 
+// FromSlice build positional data instance from the row data. eg: CSV row
+func FromSlice(row []string) *SliceData {
+	return &SliceData{Row: row}
+}
+
+// FromRawJSON build data instance from raw JSON bytes. the rule field can be a JSON pointer.
+//
+// Usage:
+//
+//	d, err := validate.FromRawJSON(body)
+//	v := d.Create()
+//	v.StringRule("/data/attributes/email", "required|email")
+func FromRawJSON(bs []byte) (*RawJSONData, error) {
+	var root any
+	if _, err := Unmarshal(nil, bs, &root); err != nil {
+		return nil, err
+	}
+	return &RawJSONData{Raw: bs, root: root}, nil
+}
+
 // FromJSONBytes string build data instance.
 func FromJSONBytes(bs []byte) (*MapData, error) {
 	mp := map[string]any{}
@@ -1,8 +1,15 @@
 package validate
 
 import (
+	"context"
 	"reflect"
+	"regexp"
+	"strconv"
 	"strings"
+	"time"
+
+	"github.com/gookit/goutil/arrutil"
+	"github.com/gookit/goutil/strutil"
 )
 
 // const requiredValidator = "required"
@@ -43,13 +50,143 @@ func (v *Validation) ValidateE(scene ...string) Errors {
 	return v.Errors
 }
 
+// Result the validate result snapshot. see Validation.Run()
+type Result struct {
+	// OK the validate is successful
+	OK bool
+	// Errors the validate errors
+	Errors Errors
+	// Safe the validated safe data
+	Safe M
+	// Filtered the filtered data
+	Filtered M
+}
+
+// Run do validate processing and return the result snapshot.
+//
+// Usage:
+//
+//	res := v.Run()
+//	if !res.OK {
+//		return res.Errors
+//	}
+func (v *Validation) Run(scene ...string) Result {
+	ok := v.Validate(scene...)
+
+	res := Result{
+		OK:       ok,
+		Errors:   make(Errors, len(v.Errors)),
+		Safe:     make(M, len(v.SaferData)),
+		Filtered: make(M, len(v.filteredData)),
+	}
+	for field, fe := range v.Errors {
+		res.Errors[field] = fe
+	}
+	for key, val := range v.SaferData {
+		res.Safe[key] = val
+	}
+	for key, val := range v.filteredData {
+		res.Filtered[key] = val
+	}
+	return res
+}
+
+// SubValidate validate a subset of the fields, with the temporary option overrides by opts.
+// it only runs the rules of the given fields, and returns the validate result of them.
+//
+// The run is independent of the main run: the options, rules and the validate
+// result of the Validation instance are restored after the sub validate.
+//
+// Usage:
+//
+//	ok := v.SubValidate([]string{"email", "password"}, func(v *Validation) {
+//		v.StopOnError = true
+//	})
+func (v *Validation) SubValidate(fields []string, opts func(*Validation)) bool {
+	saved := *v
+	defer func() {
+		*v = saved
+	}()
+
+	v.ResetResult()
+	v.rules = subFieldRules(saved.rules, fields)
+	v.filterRules = subFieldFilterRules(saved.filterRules, fields)
+	v.fieldMask = nil
+	if opts != nil {
+		opts(v)
+	}
+
+	return v.Validate(saved.scene)
+}
+
+// get the rules of the given fields, the rule fields are narrowed to the given fields.
+func subFieldRules(rules []*Rule, fields []string) []*Rule {
+	var subRules []*Rule
+	for _, rule := range rules {
+		if ruleFields := subFields(rule.fields, fields); len(ruleFields) > 0 {
+			newRule := *rule
+			newRule.fields = ruleFields
+			subRules = append(subRules, &newRule)
+		}
+	}
+	return subRules
+}
+
+// get the filter rules of the given fields, the rule fields are narrowed to the given fields.
+func subFieldFilterRules(rules []*FilterRule, fields []string) []*FilterRule {
+	var subRules []*FilterRule
+	for _, rule := range rules {
+		if ruleFields := subFields(rule.fields, fields); len(ruleFields) > 0 {
+			newRule := *rule
+			newRule.fields = ruleFields
+			subRules = append(subRules, &newRule)
+		}
+	}
+	return subRules
+}
+
+func subFields(ruleFields, fields []string) []string {
+	var ss []string
+	for _, field := range ruleFields {
+		if arrutil.StringsHas(fields, field) {
+			ss = append(ss, field)
+		}
+	}
+	return ss
+}
+
 // Validate processing
 func (v *Validation) Validate(scene ...string) bool {
+	return v.ValidateCtx(context.Background(), scene...)
+}
+
+// ValidateTimeout do validate processing with a timeout.
+// will record an error on the timeout elapses. see ValidateCtx
+func (v *Validation) ValidateTimeout(timeout time.Duration, scene ...string) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	return v.ValidateCtx(ctx, scene...)
+}
+
+// ValidateCtx do validate processing with context.
+//
+// The ctx is checked before each rule is applied, and the custom validators
+// (eg: AddValidator, SetCheckFunc) can be interrupted on the ctx is done.
+// on the ctx is done will stop validate and record the ctx error.
+//
+// NOTE: the interrupted validator func is still running in the background until it returns.
+func (v *Validation) ValidateCtx(ctx context.Context, scene ...string) bool {
 	// has been validated OR has error
 	if v.hasValidated || v.shouldStop() {
 		return v.IsSuccess()
 	}
 
+	v.ctx = ctx
+	defer func() {
+		v.ctx = nil
+	}()
+
 	// release instance to pool TODO
 	// defer func() {
 	// 	v.resetRules()
@@ -59,6 +196,20 @@ func (v *Validation) Validate(scene ...string) bool {
 	// init scene info
 	v.SetScene(scene...)
 	v.sceneFields = v.sceneFieldMap()
+	v.excludeFields = v.sceneExcludeMap()
+
+	// only validate the fields in the field mask
+	if len(v.fieldMask) > 0 {
+		if !v.checkFieldMask() && v.StopOnError {
+			v.hasValidated = true
+			return false
+		}
+
+		v.sceneFields = make(map[string]uint8, len(v.fieldMask))
+		for _, path := range v.fieldMask {
+			v.sceneFields[path] = 1
+		}
+	}
 
 	// apply filter rules before validate.
 	// if !v.Filtering() && v.StopOnError {
@@ -75,24 +226,81 @@ func (v *Validation) Validate(scene ...string) bool {
 	// modified
 	// Customization: Alter the sequence of validating data and filtering data; because filtering filters incorrect data so validation would not generate error for incorrect data.
 	// apply rule to validate data.
-	for _, rule := range v.rules {
-		if rule.Apply(v) {
+	for _, rule := range v.orderedRules() {
+		if v.ctxDone(ctx) || rule.Apply(v) {
 			break
 		}
 	}
 
 	// apply filter rules.
 	if false == v.Filtering() && v.StopOnError {
+		// stopped on error, the safe data is incomplete.
+		v.SaferData = make(map[string]any)
+		v.typedData = make(map[string]any)
 		return false
 	}
 
 	v.hasValidated = true
-	if v.hasError { // clear safe data on error.
-		v.SaferData = make(map[string]any)
+	if v.hasError {
+		// remove the failed fields, keep the passed fields. useful for repopulate the form.
+		for field := range v.failedFields {
+			delete(v.SaferData, field)
+			delete(v.typedData, field)
+		}
+	} else if v.StoreCoerced {
+		// save coerced values to safe data.
+		for field, val := range v.coercedData {
+			v.SaferData[field] = val
+		}
 	}
 	return v.IsSuccess()
 }
 
+// get the rules for apply, the required-family rules of a field always run
+// before the other rules of the field. the order of the fields is not changed.
+func (v *Validation) orderedRules() []*Rule {
+	rules := make([]*Rule, 0, len(v.rules))
+	moved := make(map[int]bool)
+
+	for i, rule := range v.rules {
+		if !rule.nameNotRequired {
+			if !moved[i] {
+				rules = append(rules, rule)
+			}
+			continue
+		}
+
+		// move the later required-family rules of the same field to before it.
+		for j := i + 1; j < len(v.rules); j++ {
+			if next := v.rules[j]; !next.nameNotRequired && !moved[j] && hasSameField(rule, next) {
+				moved[j] = true
+				rules = append(rules, next)
+			}
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+// check the two rules has the same field
+func hasSameField(r1, r2 *Rule) bool {
+	for _, field := range r1.fields {
+		if arrutil.StringsHas(r2.fields, field) {
+			return true
+		}
+	}
+	return false
+}
+
+// check the ctx is done, will add the ctx error on done.
+func (v *Validation) ctxDone(ctx context.Context) bool {
+	if err := ctx.Err(); err != nil {
+		v.WithError(err)
+		return true
+	}
+	return false
+}
+
 // Apply current rule for the rule fields
 func (r *Rule) Apply(v *Validation) (stop bool) {
 	// scene name is not match. skip the rule
@@ -108,6 +316,10 @@ func (r *Rule) Apply(v *Validation) (stop bool) {
 	var err error
 	// get real validator name
 	name := r.realName
+	// the real validator is disabled, use the input name.
+	if v.disabledValidators[name] {
+		name = r.validator
+	}
 	// validator name is not "required"
 	isNotRequired := r.nameNotRequired
 
@@ -117,13 +329,25 @@ func (r *Rule) Apply(v *Validation) (stop bool) {
 			continue
 		}
 
+		// skip the field value is not changed
+		if v.ChangedOnly && v.isUnchanged(field) {
+			continue
+		}
+
+		// the required check of the field is failed, skip other validators
+		if isNotRequired && v.requiredFailed[field] {
+			continue
+		}
+
 		// uploaded file validate
 		if isFileValidator(name) {
 			status := r.fileValidate(field, name, v)
+			if status != statusSkip {
+				v.markChecked(field)
+			}
 			if status == statusFail {
 				// build and collect error message
-				v.AddError(field, r.validator, r.errorMessage(field, r.validator, v))
-				if v.StopOnError {
+				if v.addRuleError(r, field) && v.StopOnError {
 					return true
 				}
 			}
@@ -187,18 +411,38 @@ func (r *Rule) Apply(v *Validation) (stop bool) {
 			// Customization: We need to bind all data
 			v.SaferData[field] = val
 		}
+		// transform the value just for validating
+		checkVal := val
+		if fn, ok := v.valueTransformers[field]; ok {
+			checkVal = fn(val)
+		}
+
 		// empty value AND skip on empty.
-		if r.skipEmpty && isNotRequired && IsEmpty(val) {
+		if r.skipEmpty && isNotRequired && IsEmpty(checkVal) {
 			continue
 		}
 
 		// validate field value
-		if r.valueValidate(field, name, val, v) {
+		v.markChecked(field)
+		ok := r.valueValidate(field, name, checkVal, v)
+		// interrupted by the ctx, the value is not checked.
+		if v.ctxInterrupted {
+			delete(v.SaferData, field)
+			return true
+		}
+
+		if ok {
 			if val != nil {
 				v.SaferData[field] = val // save validated value.
+				if v.StoreCoerced && coerceValidators[name] > 0 {
+					v.coercedData[field] = coerceValue(name, checkVal)
+				}
+				if tv, ok := typedValue(name, r.arguments, checkVal); ok {
+					v.typedData[field] = tv
+				}
 			}
 		} else { // build and collect error message
-			v.AddError(field, r.validator, r.errorMessage(field, r.validator, v))
+			v.addRuleError(r, field)
 		}
 
 		// Customization: To validate all the fields we need to continue iterating rather stopping on single error.
@@ -244,6 +488,8 @@ func (r *Rule) fileValidate(field, name string, v *Validation) uint8 {
 			//noinspection GoNilness
 			ok = v.InMimeTypes(form, field, ss[0], ss[1:]...)
 		}
+	case "imageDimensions":
+		ok = v.ImageDimensions(form, field, ss...)
 	}
 
 	if ok {
@@ -306,8 +552,7 @@ func (r *Rule) valueValidate(field, name string, val any, v *Validation) (ok boo
 		return false
 	}
 
-	ft := fm.fv.Type() // type of check func
-	arg0Kind := ft.In(0).Kind()
+	arg0Kind := fm.argType(0).Kind() // type of the check func "val" arg
 
 	// rftVal := reflect.Indirect(reflect.ValueOf(val))
 	rftVal := reflect.ValueOf(val)
@@ -398,7 +643,158 @@ func convValAsFuncArg0Type(arg0Kind, valKind reflect.Kind, val any) (any, bool)
 	return val, true
 }
 
+// validators that will coerce the string value on check.
+// 1: as number(int or float64) 2: as float64 3: as bool
+var coerceValidators = map[string]uint8{
+	"lt":         1,
+	"gt":         1,
+	"min":        1,
+	"max":        1,
+	"between":    1,
+	"multipleOf": 1,
+	"intEqual":   1,
+	"isInt":      1,
+	"isUint":     1,
+	"isNumber":   1,
+	"isNumeric":  1,
+	"isFloat":    2,
+	"isBool":     3,
+}
+
+// the type validators and the native types for the TypedData.
+// empty type means convert by the coerceValue()
+var typedValidators = map[string]string{
+	"isInt":     "",
+	"isUint":    "",
+	"isNumber":  "",
+	"isFloat":   "",
+	"isBool":    "",
+	"isInt8":    "int8",
+	"isInt16":   "int16",
+	"isInt32":   "int32",
+	"isInt64":   "int64",
+	"isUint8":   "uint8",
+	"isUint16":  "uint16",
+	"isUint32":  "uint32",
+	"isUint64":  "uint64",
+	"isFloat32": "float32",
+	"isFloat64": "float64",
+}
+
+// convert the checked value to the native type of the type validator. eg: "isInt8" -> int8
+func typedValue(name string, args []any, val any) (any, bool) {
+	typ, ok := typedValidators[name]
+	if name == "numType" && len(args) > 0 {
+		typ, ok = strutil.QuietString(args[0]), true
+	}
+
+	if !ok {
+		return nil, false
+	}
+	if typ == "" {
+		return coerceValue(name, val), true
+	}
+	return convNumOf(val, numOfTypes[typ])
+}
+
+// coerce the string value to the type used by the validator on check.
+// if it cannot be coerced, will return the original value.
+func coerceValue(name string, val any) any {
+	str, ok := val.(string)
+	if !ok {
+		return val
+	}
+
+	str = strings.TrimSpace(str)
+	switch coerceValidators[name] {
+	case 1:
+		if iVal, err := strconv.Atoi(str); err == nil {
+			return iVal
+		}
+		if fVal, err := strconv.ParseFloat(str, 64); err == nil {
+			return fVal
+		}
+	case 2:
+		if fVal, err := strconv.ParseFloat(str, 64); err == nil {
+			return fVal
+		}
+	case 3:
+		if bVal, err := strutil.ToBool(str); err == nil {
+			return bVal
+		}
+	}
+	return val
+}
+
+// the forbidden validators check the field presence like the "requiredXXX",
+// so they are not skipped on the value is empty.
+var presenceValidators = map[string]bool{
+	"forbidden":   true,
+	"forbiddenIf": true,
+}
+
+// check the validator is "requiredXXX" or checks the field presence.
+func isPresenceValidator(name string) bool {
+	return strings.HasPrefix(name, "required") || presenceValidators[name]
+}
+
+// the field compare validators, the first arg is the dst field.
+var fieldCompareValidators = map[string]bool{
+	"eqField":    true,
+	"neField":    true,
+	"gtField":    true,
+	"gteField":   true,
+	"ltField":    true,
+	"lteField":   true,
+	"lenEqField": true,
+	"sameLen":    true,
+}
+
+// resolve the "../" dst field path relative to the object of the field, the array index is skipped.
+// if it is out of the top level, the remaining "../" will be resolved by the parent validation.
+//
+//	"items.0.price" + "../budget" -> "budget"
+//	"order.items.*.price" + "../budget" -> "order.budget"
+//	"price" + "../budget" -> "../budget" // by the parent validation
+func resolveRelField(field, dst string) string {
+	if !strings.HasPrefix(dst, "../") {
+		return dst
+	}
+
+	segs := strings.Split(field, ".")
+	segs = segs[:len(segs)-1]
+	for strings.HasPrefix(dst, "../") {
+		// skip the array index. eg: "items.0", "items.*"
+		for len(segs) > 0 && isIndexSegment(segs[len(segs)-1]) {
+			segs = segs[:len(segs)-1]
+		}
+		if len(segs) == 0 {
+			break
+		}
+
+		segs = segs[:len(segs)-1]
+		dst = dst[3:]
+	}
+	return strings.Join(append(segs, dst), ".")
+}
+
+// check the path segment is an array index. eg: "0", "*"
+func isIndexSegment(seg string) bool {
+	if seg == "*" {
+		return true
+	}
+	_, err := strconv.Atoi(seg)
+	return err == nil
+}
+
 func callValidator(v *Validation, fm *funcMeta, field string, val any, args []any) (ok bool) {
+	// resolve the relative dst field. eg: "items.0.price" with "lteField:../budget"
+	if fieldCompareValidators[fm.name] && len(args) > 0 {
+		if dst, isStr := args[0].(string); isStr && strings.HasPrefix(dst, "../") {
+			args = append([]any{resolveRelField(field, dst)}, args[1:]...)
+		}
+	}
+
 	// use `switch` can avoid using reflection to call methods and improve speed
 	// fm.name please see pkg var: validatorValues
 	switch fm.name {
@@ -416,6 +812,16 @@ func callValidator(v *Validation, fm *funcMeta, field string, val any, args []an
 		ok = v.RequiredWithout(field, val, args2strings(args)...)
 	case "requiredWithoutAll":
 		ok = v.RequiredWithoutAll(field, val, args2strings(args)...)
+	case "requiredAtLeast":
+		ok = v.RequiredAtLeast(field, val, args2strings(args)...)
+	case "requiredTogether":
+		ok = v.RequiredTogether(field, val, args2strings(args)...)
+	case "requiredKeys":
+		ok = v.RequiredKeys(field, val, args2strings(args)...)
+	case "forbidden":
+		ok = v.Forbidden(field, val)
+	case "forbiddenIf":
+		ok = v.ForbiddenIf(field, val, args2strings(args)...)
 	case "lt":
 		ok = Lt(val, args[0])
 	case "gt":
@@ -468,20 +874,131 @@ func callValidator(v *Validation, fm *funcMeta, field string, val any, args []an
 		ok = IsJSON(val.(string))
 	case "isSlice":
 		ok = IsSlice(val)
+	case "dateTime":
+		t, layout, matched := parseDateTime(strutil.QuietString(val), args2strings(args))
+		if ok = matched; ok {
+			v.parsedTimes[field] = ParsedTime{Time: t, Layout: layout}
+			v.typedData[field] = t
+			if v.StoreCoerced {
+				v.coercedData[field] = t
+			}
+		}
+	case "format":
+		format := args[0].(string)
+		if _, has := openAPIFormats[format]; !has {
+			v.AddWarning(field, fm.name, "unknown format '"+format+"', skip the check")
+		}
+		ok = IsFormat(val, format)
+	case "allowedKeys":
+		extra, isMap := extraKeys(val, args2strings(args))
+		if ok = isMap && len(extra) == 0; !ok {
+			v.setMessageArgs(field, strings.Join(extra, ", "))
+		}
+	case "noEmptyItems":
+		var index int
+		if index, ok = firstEmptyItem(val); !ok && index >= 0 {
+			v.setMessageArgs(field, index)
+		}
+	case "uniqueFold":
+		var i, j int
+		if i, j, ok = foldDuplicate(val, len(args) > 0 && args[0] == true); !ok && j >= 0 {
+			rv := reflect.Indirect(reflect.ValueOf(val))
+			v.setMessageArgs(field, rv.Index(j).Interface(), j, rv.Index(i).Interface(), i)
+		}
+	case "isSorted":
+		order := "asc"
+		if len(args) > 0 {
+			order = args[0].(string)
+		}
+		index := SortedIndex(val, order)
+		if ok = index == -1; !ok {
+			v.setMessageKey(field, "isSortedIndex", order, index)
+		}
+	case "isRegex":
+		_, err := regexp.Compile(strutil.QuietString(val))
+		if ok = err == nil; !ok {
+			v.setMessageArgs(field, err.Error())
+		}
+	case "isYAML":
+		kind := args2strings(args)
+		if ok = IsYAML(strutil.QuietString(val), kind...); !ok && len(kind) > 0 {
+			v.setMessageKey(field, "isYAMLMap")
+		}
+	case "decimals":
+		mode := args2strings(args[1:])
+		if ok = Decimals(val, args[0].(int), mode...); !ok && len(mode) > 0 && mode[0] == "exact" {
+			v.setMessageKey(field, "decimalsExact", args[0])
+		}
+	case "sameLen":
+		var valLen, dstLen int
+		if valLen, dstLen, ok = v.sameLen(val, args[0].(string)); !ok {
+			v.setMessageArgs(field, args[0], valLen, dstLen)
+		}
+	case "lenEqField":
+		var dstVal any
+		if dstVal, ok = v.lenEqField(val, args[0].(string)); !ok {
+			v.setMessageArgs(field, args[0], dstVal, runeLen(val))
+		}
 	default:
 		// 3. call user custom validators, will call by reflect
-		ok = callValidatorValue(fm.fv, val, args)
+		if fm.builtin {
+			ok = callFuncMeta(v, fm, val, args)
+		} else {
+			// the custom validator maybe hang, can be interrupted by the ctx.
+			ok = v.callWithCtx(func() bool {
+				return callFuncMeta(v, fm, val, args)
+			})
+		}
 	}
 	return
 }
 
+func callFuncMeta(v *Validation, fm *funcMeta, val any, args []any) bool {
+	// fast path: call the "func(s string) bool" validator directly, no need reflect.
+	if fm.strFunc != nil && len(args) == 0 {
+		if str, isStr := val.(string); isStr {
+			return fm.strFunc(str)
+		}
+	}
+
+	if fm.withValidation {
+		return callValidatorValue(fm.fv, val, args, reflect.ValueOf(v))
+	}
+	return callValidatorValue(fm.fv, val, args)
+}
+
+// call the validator func, will return on the ctx of ValidateCtx is done.
+// on interrupted, will record the ctx error and return true, so no rule error is added.
+func (v *Validation) callWithCtx(fn func() bool) bool {
+	if v.ctx == nil || v.ctx.Done() == nil {
+		return fn()
+	}
+
+	if v.ctxInterrupted {
+		return true
+	}
+
+	done := make(chan bool, 1)
+	go func() {
+		done <- fn()
+	}()
+
+	select {
+	case ok := <-done:
+		return ok
+	case <-v.ctx.Done():
+		v.ctxInterrupted = true
+		v.WithError(v.ctx.Err())
+		return true
+	}
+}
+
 // convert args data type
 func convertArgsType(v *Validation, fm *funcMeta, field string, args []any) (ok bool) {
 	if len(args) == 0 {
 		return true
 	}
 
-	ft := fm.fv.Type()
 	lastTyp := reflect.Invalid
 	lastArgIndex := fm.numIn - 1
 
@@ -489,7 +1006,7 @@ func convertArgsType(v *Validation, fm *funcMeta, field string, args []any) (ok
 	// eg: "...int64" -> slice "[]int64"
 	if fm.isVariadic {
 		// get variadic kind. "[]int64" -> reflect.Int64
-		lastTyp = getVariadicKind(ft.In(lastArgIndex))
+		lastTyp = getVariadicKind(fm.argType(lastArgIndex))
 	}
 
 	// only one args and type is any
@@ -531,7 +1048,7 @@ func convertArgsType(v *Validation, fm *funcMeta, field string, args []any) (ok
 		}
 
 		// "+1" because func first arg is val, need skip it.
-		argIType := ft.In(fcArgIndex)
+		argIType := fm.argType(fcArgIndex)
 		wantKind = argIType.Kind()
 
 		// type is same. or want type is interface
@@ -559,7 +1076,8 @@ func convertArgsType(v *Validation, fm *funcMeta, field string, args []any) (ok
 	return true
 }
 
-func callValidatorValue(fv reflect.Value, val any, args []any) bool {
+// the prefix is the leading args before "val". eg: the validation instance
+func callValidatorValue(fv reflect.Value, val any, args []any, prefix ...reflect.Value) bool {
 	// build params for the validator func.
 	argNum := len(args)
 	argIn := make([]reflect.Value, argNum+1)
@@ -597,6 +1115,10 @@ func callValidatorValue(fv reflect.Value, val any, args []any) bool {
 	// 	}
 	// }()
 
+	if len(prefix) > 0 {
+		argIn = append(prefix, argIn...)
+	}
+
 	// NOTICE: f.CallSlice()与Call() 不一样的是，CallSlice参数的最后一个会被展开
 	// vs := fv.Call(argIn)
 	return fv.Call(argIn)[0].Bool()
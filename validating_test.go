@@ -1,7 +1,13 @@
 package validate
 
 import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/gookit/goutil/dump"
 	"github.com/gookit/goutil/maputil"
@@ -256,6 +262,131 @@ func TestValidation_RequiredWithoutAll(t *testing.T) {
 	assert.Equal(t, "Name field is required when none of [Age,City] are present", v.Errors.One())
 }
 
+func TestValidation_RequiredAtLeast(t *testing.T) {
+	is := assert.New(t)
+
+	// exactly N
+	v := New(M{"a": "1", "b": "", "c": 3})
+	v.StringRule("contact", "at_least:2:a,b,c,d")
+	is.True(v.Validate())
+
+	v = New(M{"a": "1", "b": "2", "c": 3})
+	v.AddRule("contact", "atLeast", "2", "a", "b", "c", "d")
+	is.True(v.Validate())
+
+	// fewer than N
+	v = New(M{"a": "1", "b": ""})
+	v.StringRule("contact", "at_least:2:a,b,c,d")
+	is.False(v.Validate())
+	is.Equal("contact requires at least 2 of a, b, c, d to be present, but found 1", v.Errors.FieldOne("contact"))
+
+	// invalid args
+	is.False(v.RequiredAtLeast("contact", nil, "2"))
+	is.False(v.RequiredAtLeast("contact", nil, "abc", "a"))
+}
+
+func TestValidation_RequiredTogether(t *testing.T) {
+	is := assert.New(t)
+
+	// none present
+	v := New(M{"name": "inhere"})
+	v.StringRule("address", "together:city,country")
+	is.True(v.Validate())
+
+	// all present
+	v = New(M{"city": "Chengdu", "country": "CN"})
+	v.StringRule("address", "together:city,country")
+	is.True(v.Validate())
+
+	// partial present
+	v = New(M{"city": "Chengdu", "country": ""})
+	v.StringRule("address", "required_together:city,country")
+	is.False(v.Validate())
+	is.Equal("address requires city, country to be present together, missing country", v.Errors.FieldOne("address"))
+}
+
+func TestValidation_LenEqField(t *testing.T) {
+	is := assert.New(t)
+
+	v := New(M{"code": "ab中d", "codeLen": "4"})
+	v.StringRule("code", "lenEqField:codeLen")
+	is.True(v.Validate())
+
+	// length and the field value disagree
+	v = New(M{"code": "abc", "codeLen": 4})
+	v.StringRule("code", "len_eq_field:codeLen")
+	is.False(v.Validate())
+	is.Equal("code length must be equal to the field codeLen value 4, but got length 3", v.Errors.FieldOne("code"))
+
+	// "lenEq" with a field name
+	v = New(M{"code": "abc", "n": 4})
+	v.StringRule("code", "lenEq:n")
+	is.False(v.Validate())
+	is.Equal("code length must be equal to the field n value 4, but got length 3", v.Errors.FieldOne("code"))
+
+	v = New(M{"code": "abcd", "n": "4"})
+	v.StringRule("code", "lenEq:n")
+	is.True(v.Validate())
+
+	// "lenEq" with an int still check the length
+	v = New(M{"code": "abcd"})
+	v.StringRule("code", "lenEq:4")
+	is.True(v.Validate())
+
+	// dst field is missing or not an int
+	is.False(v.LenEqField("abc", "notExist"))
+	v = New(M{"codeLen": "four"})
+	is.False(v.LenEqField("abc", "codeLen"))
+}
+
+func TestValidation_SameLen(t *testing.T) {
+	is := assert.New(t)
+
+	v := New(M{"names": []string{"tom", "john"}, "ages": []int{23, 25}})
+	v.StringRule("names", "sameLen:ages")
+	is.True(v.Validate())
+
+	v = New(M{"names": []string{"tom", "john"}, "ages": []int{23, 25, 30}})
+	v.StringRule("names", "same_len:ages")
+	is.False(v.Validate())
+	is.Equal("names length must be equal to the length of the field ages, but got 2 and 3", v.Errors.FieldOne("names"))
+
+	is.False(v.SameLen([]int{1}, "notExists"))
+	is.False(v.SameLen(23, "ages"))
+	is.True(v.SameLen([3]int{}, "ages"))
+}
+
+func TestValidation_HMAC(t *testing.T) {
+	is := assert.New(t)
+
+	body := `{"action": "opened", "number": 23}`
+	mac := hmac.New(sha256.New, []byte("s3cret"))
+	mac.Write([]byte(body))
+	sig := hex.EncodeToString(mac.Sum(nil))
+
+	// matched, allow the algo prefix
+	for _, signature := range []string{sig, "sha256=" + sig} {
+		v := New(M{"body": body, "secret": "s3cret", "signature": signature})
+		v.StringRule("body", "hmac:sha256,secret,signature")
+		is.True(v.Validate(), signature)
+	}
+
+	// tampered payload
+	v := New(M{"body": `{"action": "closed", "number": 23}`, "secret": "s3cret", "signature": sig})
+	v.StringRule("body", "hmac:sha256,secret,signature")
+	is.False(v.Validate())
+	is.Equal("body value does not match the signature", v.Errors.FieldOne("body"))
+
+	// wrong secret, invalid hex and missing signature
+	v = New(M{"body": body, "secret": "other", "signature": sig, "bad": "xyz"})
+	is.False(v.HMAC(body, "sha256", "secret", "signature"))
+	is.False(v.HMAC(body, "sha256", "secret", "bad"))
+	is.False(v.HMAC(body, "sha256", "secret", "notExists"))
+	is.Panics(func() {
+		v.HMAC(body, "crc32", "secret", "signature")
+	})
+}
+
 func TestVariadicArgs(t *testing.T) {
 	// use custom validator
 	v := New(M{
@@ -482,3 +613,450 @@ func TestRequired_MissingParentField(t *testing.T) {
 	assert.StrContains(t, s, "coding.*.details.cpt.*.encounter_uid is required")
 	assert.StrContains(t, s, "coding.*.details.cpt.*.not_exist_field is required")
 }
+
+func TestValidation_partialSafeData(t *testing.T) {
+	is := assert.New(t)
+
+	v := New(M{"name": "inhere", "email": "invalid"})
+	v.StopOnError = false
+	v.StringRule("name", "required|minLen:3")
+	v.StringRule("email", "required|email")
+	is.False(v.Validate())
+	is.True(v.Errors.HasField("email"))
+	// the passed field is kept in the safe data
+	is.Equal("inhere", v.SafeVal("name"))
+	is.NotContains(v.SafeData(), "email")
+	is.Equal(M{"name": "inhere"}, v.SafeData())
+
+	// struct with json tags, the errors key is the output name
+	type user struct {
+		Name  string `json:"name" validate:"required|minLen:2"`
+		Email string `json:"email" validate:"required|email"`
+	}
+	v = Struct(&user{Name: "ab", Email: "bad"})
+	v.StopOnError = false
+	is.False(v.Validate())
+	is.True(v.Errors.HasField("email"))
+	is.Equal(M{"Name": "ab"}, v.SafeData())
+
+	// stopped on error, the safe data is incomplete
+	v = New(M{"name": "inhere", "email": "invalid"})
+	v.StringRule("name", "required|minLen:3")
+	v.StringRule("email", "required|email")
+	is.False(v.Validate())
+	is.Empty(v.SafeData())
+}
+
+func TestValidation_StoreCoerced(t *testing.T) {
+	is := assert.New(t)
+	mp := M{"age": "18", "name": "inhere"}
+
+	// default: not store coerced value
+	v := Map(mp)
+	v.StringRules(MS{"age": "required|gte:18", "name": "required"})
+	is.True(v.Validate())
+	is.Eq("18", v.SafeVal("age"))
+
+	v = Map(mp)
+	v.StoreCoerced = true
+	v.StringRules(MS{"age": "gte:18|required", "name": "required"})
+	is.True(v.Validate())
+	is.Eq(18, v.SafeVal("age"))
+	is.Eq("inhere", v.SafeVal("name"))
+	// source data is not changed
+	is.Eq("18", mp["age"])
+
+	u := &struct {
+		Age  int    `json:"age"`
+		Name string `json:"name"`
+	}{}
+	_, err := v.BindStruct(u)
+	is.NoErr(err)
+	is.Eq(18, u.Age)
+}
+
+func TestValidation_TypedData(t *testing.T) {
+	is := assert.New(t)
+	mp := M{"level": "12", "port": "8080", "ratio": "0.5", "active": "true", "name": "inhere"}
+
+	v := Map(mp)
+	v.StringRules(MS{
+		"level":  "required|int8",
+		"port":   "num_type:uint16",
+		"ratio":  "isFloat",
+		"active": "bool",
+		"name":   "required",
+	})
+	is.True(v.Validate())
+
+	td := v.TypedData()
+	is.Eq(int8(12), td["level"])
+	is.Eq(uint16(8080), td["port"])
+	is.Eq(0.5, td["ratio"])
+	is.Eq(true, td["active"])
+	is.Eq("inhere", td["name"])
+	// the safe data keeps the original value
+	is.Eq("12", v.SafeVal("level"))
+
+	// the failed fields are not in typed data
+	v = Map(M{"level": "300", "port": "8080"})
+	v.StopOnError = false
+	v.StringRules(MS{"level": "int8", "port": "uint16"})
+	is.False(v.Validate())
+	is.Eq(M{"port": uint16(8080)}, v.TypedData())
+
+	// struct with json tags
+	type server struct {
+		Level int    `json:"level" validate:"int8"`
+		Port  string `json:"port" validate:"uint16"`
+	}
+	v = Struct(&server{Level: 300, Port: "8080"})
+	v.StopOnError = false
+	is.False(v.Validate())
+	is.True(v.Errors.HasField("level"))
+	is.Eq(M{"Port": uint16(8080)}, v.TypedData())
+}
+
+func TestValidation_MinAge(t *testing.T) {
+	is := assert.New(t)
+	clock := func() time.Time {
+		return time.Date(2024, 5, 20, 10, 0, 0, 0, time.UTC)
+	}
+
+	// just turned 18
+	v := New(M{"dob": "2006-05-20"}).WithClock(clock)
+	v.StringRule("dob", "required|min_age:18")
+	is.True(v.Validate())
+
+	// one day before 18
+	v = New(M{"dob": "2006-05-21"}).WithClock(clock)
+	v.StringRule("dob", "required|min_age:18")
+	is.False(v.Validate())
+	is.Equal("dob age should be at least 18", v.Errors.One())
+
+	// max age, allow time.Time
+	v = New(M{"dob": time.Date(1964, 5, 21, 0, 0, 0, 0, time.UTC)}).WithClock(clock)
+	v.StringRule("dob", "required|max_age:59")
+	is.True(v.Validate())
+
+	v = New(M{"dob": "1964-05-20"}).WithClock(clock)
+	v.StringRule("dob", "required|maxAge:59")
+	is.False(v.Validate())
+
+	is.False(v.MinAge("invalid", 18))
+	is.False(v.MinAge(nil, 18))
+}
+
+func TestValidation_requiredRunFirst(t *testing.T) {
+	is := assert.New(t)
+
+	v := New(M{"email": ""})
+	v.SkipOnEmpty = false
+	v.StringRule("email", "email|required")
+	is.False(v.Validate())
+	is.Equal(MS{"required": "email is required to not be empty"}, v.Errors["email"])
+
+	// required failed, skip the rest validators for the field
+	v = New(M{"name": "", "age": "abc"})
+	v.StopOnError = false
+	v.StringRule("name", "minLen:3|required|maxLen:5")
+	v.StringRule("age", "int|required")
+	is.False(v.Validate())
+	is.Equal(MS{"required": "name is required to not be empty"}, v.Errors["name"])
+	is.Contains(v.Errors["age"], "int")
+
+	// only reorder the rules in the field, the order of the fields is not changed
+	v = New(M{"email": "invalid", "name": ""})
+	v.StringRule("email", "email")
+	v.StringRule("name", "minLen:3|required")
+	v.StringRule("age", "required")
+
+	var names []string
+	for _, rule := range v.orderedRules() {
+		names = append(names, rule.fields[0]+"."+rule.validator)
+	}
+	is.Equal([]string{"email.email", "name.required", "name.minLen", "age.required"}, names)
+	is.False(v.Validate())
+	is.Len(v.Errors, 3)
+	is.Equal(MS{"required": "name is required to not be empty"}, v.Errors["name"])
+}
+
+func TestValidation_WithinDays(t *testing.T) {
+	is := assert.New(t)
+
+	v := New(M{"start_date": "2024-01-01", "end_date": "2024-01-21"})
+	v.StringRule("end_date", "within_days:30:start_date|min_days:1:start_date")
+	is.True(v.Validate())
+
+	// 45 days span
+	v = New(M{"start_date": "2024-01-01", "end_date": "2024-02-15"})
+	v.StringRule("end_date", "within_days:30:start_date")
+	is.False(v.Validate())
+	is.Equal("end_date should be within 30 days of start_date", v.Errors.One())
+
+	v = New(M{"start_date": "2024-01-01", "end_date": "2024-01-01"})
+	v.StringRule("end_date", "minDays:1:start_date")
+	is.False(v.Validate())
+
+	is.False(v.WithinDays("invalid", 30, "start_date"))
+	is.False(v.WithinDays("2024-01-02", 30, "not_exist"))
+}
+
+func TestValidation_Forbidden(t *testing.T) {
+	is := assert.New(t)
+
+	v := New(M{"name": "inhere"})
+	v.StringRule("role", "forbidden")
+	is.True(v.Validate())
+
+	// present forbidden field
+	v = New(M{"name": "inhere", "role": "admin"})
+	v.StringRule("role", "forbidden")
+	is.False(v.Validate())
+	is.Equal("role is not allowed to be submitted", v.Errors.One())
+
+	// present with empty value
+	v = New(M{"name": "inhere", "role": ""})
+	v.StringRule("role", "forbidden")
+	is.False(v.Validate())
+	is.True(v.Errors.HasField("role"))
+
+	// conditionally forbidden
+	v = New(M{"type": "member", "discount": 10})
+	v.StringRule("discount", "forbidden_if:type,guest")
+	is.True(v.Validate())
+
+	v = New(M{"type": "guest", "discount": 10})
+	v.StringRule("discount", "forbidden_if:type,guest,visitor")
+	is.False(v.Validate())
+	is.Equal("discount is not allowed when type is in [guest,visitor]", v.Errors.One())
+
+	v = New(M{"type": "guest"})
+	v.StringRule("discount", "forbidden_if:type,guest")
+	is.True(v.Validate())
+
+	v = New(M{"type": "guest", "discount": ""})
+	v.StringRule("discount", "forbidden_if:type,guest")
+	is.False(v.Validate())
+
+	// struct: zero value is not submitted
+	type order struct {
+		Type     string
+		Discount int
+	}
+	v = Struct(&order{Type: "guest"})
+	v.StringRule("Discount", "forbidden_if:Type,guest")
+	is.True(v.Validate())
+
+	v = Struct(&order{Type: "guest", Discount: 10})
+	v.StringRule("Discount", "forbidden_if:Type,guest")
+	is.False(v.Validate())
+}
+
+func TestValidation_Run(t *testing.T) {
+	is := assert.New(t)
+
+	v := New(M{"name": " inhere ", "age": 23})
+	v.StringRule("name", "required|minLen:3", "trim")
+	v.StringRule("age", "required|int")
+	res := v.Run()
+	is.True(res.OK)
+	is.Equal(v.IsOK(), res.OK)
+	is.Equal(v.Errors, res.Errors)
+	is.Equal(v.SafeData(), res.Safe)
+	is.Equal(v.FilteredData(), res.Filtered)
+	is.Equal("inhere", res.Filtered["name"])
+
+	v = New(M{"name": "in"})
+	v.StringRule("name", "required|minLen:3")
+	res = v.Run()
+	is.False(res.OK)
+	is.Equal(v.IsOK(), res.OK)
+	is.Equal(v.Errors, res.Errors)
+	is.True(res.Errors.HasField("name"))
+	is.Equal(v.SafeData(), res.Safe)
+}
+
+func TestValidation_SubValidate(t *testing.T) {
+	is := assert.New(t)
+
+	v := New(M{"email": "invalid", "password": "123456", "age": 3})
+	v.StopOnError = false
+	v.StringRule("email", "required|email")
+	v.StringRule("password", "required|minLen:6")
+	v.StringRule("age", "min:18")
+
+	// validate two fields with StopOnError on
+	var subStop bool
+	ok := v.SubValidate([]string{"email", "password"}, func(v *Validation) {
+		v.StopOnError = true
+		v.StringRule("password", "maxLen:3")
+		subStop = v.StopOnError
+	})
+	is.False(ok)
+	is.True(subStop)
+
+	// the options, rules and result are restored after the sub validate
+	is.False(v.StopOnError)
+	is.Empty(v.Errors)
+	is.Len(v.rules, 5)
+
+	// main run is not affected
+	is.False(v.Validate())
+	is.True(v.Errors.HasField("email"))
+	is.False(v.Errors.HasField("password"))
+	is.True(v.Errors.HasField("age"))
+
+	// the multi fields rule is narrowed to the sub fields
+	v = New(M{"email": "some@example.com", "password": "123456", "age": 3})
+	v.StringRule("email,password", "required|string")
+	v.StringRule("age", "min:18")
+	is.True(v.SubValidate([]string{"email", "password"}, nil))
+	is.True(v.SubValidate([]string{"password"}, nil))
+	is.False(v.SubValidate([]string{"age"}, nil))
+	is.False(v.Validate())
+	is.Equal("age min value is 18", v.Errors.One())
+}
+
+func TestValidation_ValidateTimeout(t *testing.T) {
+	is := assert.New(t)
+
+	v := New(M{"name": "inhere", "age": 23})
+	v.AddRule("name", "required")
+	v.AddRule("name", "slowCheck").SetCheckFunc(func(val any) bool {
+		time.Sleep(2 * time.Second)
+		return true
+	})
+	v.AddRule("age", "min", 1)
+
+	// the hanging validator is interrupted on the timeout
+	start := time.Now()
+	is.False(v.ValidateTimeout(20 * time.Millisecond))
+	is.True(time.Since(start) < time.Second)
+	is.True(v.Errors.HasField("_validate"))
+	is.False(v.Errors.HasField("name"))
+	is.Equal("context deadline exceeded", v.Errors.FieldOne("_validate"))
+	_, ok := v.Safe("name")
+	is.False(ok)
+
+	// the custom validator by AddValidator
+	v = New(M{"name": "inhere"})
+	v.AddValidator("slowCheck", func(val any) bool {
+		time.Sleep(2 * time.Second)
+		return true
+	})
+	v.StringRule("name", "required|slowCheck")
+	start = time.Now()
+	is.False(v.ValidateTimeout(20 * time.Millisecond))
+	is.True(time.Since(start) < time.Second)
+	is.Equal("context deadline exceeded", v.Errors.One())
+
+	// not timeout
+	v = New(M{"name": "inhere"})
+	v.AddRule("name", "required")
+	is.True(v.ValidateTimeout(time.Second))
+
+	// canceled ctx
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	v = New(M{"name": "inhere"})
+	v.AddRule("name", "required")
+	is.False(v.ValidateCtx(ctx))
+	is.Equal("context canceled", v.Errors.One())
+
+	// the ctx is done after all rules are applied, keep the result
+	ctx, cancel = context.WithCancel(context.Background())
+	v = New(M{"name": "inhere"})
+	v.WithValueTransformer("name", func(val any) any {
+		cancel()
+		return val
+	})
+	v.StringRule("name", "required")
+	is.True(v.ValidateCtx(ctx))
+}
+
+func TestValidation_WithValueTransformer(t *testing.T) {
+	is := assert.New(t)
+
+	v := New(M{"name": "inhere   "})
+	v.StringRule("name", "required|maxLen:6")
+	is.False(v.Validate())
+	is.True(v.Errors.HasField("name"))
+
+	v = New(M{"name": "inhere   "})
+	v.StringRule("name", "required|maxLen:6")
+	v.WithValueTransformer("name", func(val any) any {
+		return strings.TrimSpace(val.(string))
+	})
+	is.True(v.Validate())
+	// non-persistent
+	is.Equal("inhere   ", v.SafeVal("name"))
+	is.Empty(v.FilteredData())
+	is.Equal("inhere   ", v.RawVal("name"))
+}
+
+func TestValidation_ErrorsByValidator(t *testing.T) {
+	is := assert.New(t)
+
+	v := New(M{"email": "invalid", "code": "ab"})
+	v.StopOnError = false
+	v.StringRule("name", "required")
+	v.StringRule("age", "required|int")
+	v.StringRule("email", "email")
+	v.StringRule("code", "minLen:3")
+	is.False(v.Validate())
+
+	groups := v.ErrorsByValidator()
+	is.Equal([]string{"age", "name"}, groups["required"])
+	is.Equal([]string{"email"}, groups["isEmail"])
+	is.Equal([]string{"code"}, groups["minLength"])
+	is.Len(groups, 3)
+}
+
+func TestValidation_FirstError(t *testing.T) {
+	is := assert.New(t)
+
+	v := New(M{"email": "invalid", "code": "ab", "age": 23})
+	v.StopOnError = false
+	v.StringRule("age", "int")
+	v.StringRule("code", "minLen:3")
+	v.StringRule("email", "email")
+	is.Nil(v.FirstError())
+	is.False(v.Validate())
+
+	err := v.FirstError()
+	is.Err(err)
+	fe, ok := err.(*FieldError)
+	is.True(ok)
+	is.Equal("code", fe.Field)
+	is.Equal("minLen", fe.Validator)
+	is.Equal(v.Errors.FieldOne("code"), err.Error())
+
+	v.ResetResult()
+	is.Nil(v.FirstError())
+
+	v = New(M{"age": 23})
+	v.StringRule("age", "int|min:1")
+	is.True(v.Validate())
+	is.Nil(v.FirstError())
+}
+
+func TestValidation_PassedFields(t *testing.T) {
+	is := assert.New(t)
+
+	v := New(M{"name": "inhere", "age": 10, "email": "invalid", "city": ""})
+	v.StringRule("name", "required|minLen:2")
+	v.StringRule("age", "min:18")
+	v.StringRule("email", "email")
+	// skip on empty, rule not run
+	v.StringRule("city", "minLen:2")
+	v.StringRule("name", "maxLen:10")
+	is.False(v.Validate())
+
+	is.Equal([]string{"name"}, v.PassedFields())
+	is.True(v.Errors.HasField("age"))
+	is.True(v.Errors.HasField("email"))
+
+	v.ResetResult()
+	is.Empty(v.PassedFields())
+}
@@ -1,9 +1,16 @@
 package validate
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"reflect"
+	"sort"
 	"strings"
+	"time"
+
+	"github.com/gookit/goutil/arrutil"
+	"github.com/gookit/goutil/strutil"
 )
 
 // some default value settings.
@@ -11,6 +18,7 @@ const (
 	fieldTag  = "json"
 	filterTag = "filter"
 	labelTag  = "label"
+	indexTag  = "index"
 
 	messageTag  = "message"
 	validateTag = "validate"
@@ -18,6 +26,9 @@ const (
 	filterError   = "_filter"
 	validateError = "_validate"
 
+	// the default max nesting depth for collect struct rules. see GlobalOption.MaxDepth
+	defaultMaxDepth = 32
+
 	// sniff Length, use for detect file mime type
 	sniffLen = 512
 	// 32 MB
@@ -44,11 +55,45 @@ type Validation struct {
 	SaferData M  // Customization: We need to update the typo of safeData to SaferData to access the variable outside the package for manual validation.
 	// filtered clean data
 	filteredData M
+	// coerced values on validate. see StoreCoerced
+	coercedData M
+	// the parsed times by the dateTime validator. see ParsedTime
+	parsedTimes map[string]ParsedTime
+	// the values converted to the native types by the type validators. see TypedData
+	typedData M
+	// the fields that had at least one rule run. see PassedFields
+	checkedFields []string
+	// the set of the checkedFields, for fast lookup.
+	checkedSet map[string]struct{}
+	// the fields that failed on the required-family validator.
+	requiredFailed map[string]bool
+	// the source field names that failed on any rule check.
+	failedFields map[string]bool
+	// the first added field error. see FirstError
+	firstErr *FieldError
+	// the source struct fields of the errors. see DebugErrors
+	errSources map[string]FieldSource
+	// the error codes in the order of added. see ErrorCodes
+	errCodes []FieldCode
+	// the set of the errCodes, for fast lookup.
+	errCodeSet map[FieldCode]struct{}
+	// the message args recorded by the failed validators. see setMessageArgs
+	msgArgs map[string]messageArgs
 	// save user custom set default values
 	defValues map[string]any
+	// value transformers for fields. see WithValueTransformer
+	valueTransformers map[string]func(val any) any
+	// the filter for all string fields. see WithDefaultStringFilter
+	defStringFilter string
+	// field aliases, format: {rule field name: source key}. see WithFieldAliases
+	fieldAliases map[string]string
 
 	// Errors for validate
 	Errors Errors
+	// Warnings for validate, the advisory errors. will not affect the validate result.
+	//
+	// see AddWarning() and WarnOn()
+	Warnings Errors
 	// CacheKey for cache rules
 	// CacheKey string
 	// StopOnError If true: An error occurs, it will cease to continue to verify
@@ -59,6 +104,32 @@ type Validation struct {
 	UpdateSource bool
 	// CheckDefault Whether to validate the default value set by the user
 	CheckDefault bool
+	// StoreCoerced Whether to save the coerced value to the safe data after validate passed.
+	// eg: input "18" with rule "gte:18", will save int 18 to safe data.
+	//
+	// NOTE: only the safe data is changed, the source field value will not
+	// be updated by the coerced value, even if UpdateSource is true.
+	StoreCoerced bool
+	// AllowNilData Whether to allow the source data is nil. If true, Set() on nil data
+	// will be a no-op and returns nil, rather than returns ErrEmptyData.
+	//
+	// Useful for use NewEmpty() as a pure rule container, before attaching data.
+	AllowNilData bool
+	// ChangedOnly Whether to validate only the changed fields, compare with
+	// the baseline data. see WithBaseline
+	//
+	// Useful for update endpoints, has old entity and the incoming patch data.
+	ChangedOnly bool
+	// CollectFilterErrors Whether to continue filtering past the filter errors.
+	// If true, each filter error will be recorded under its field, rather than
+	// records one "_filter" error and break.
+	CollectFilterErrors bool
+	// SourceKeyErrors Whether to use the source key as the error field name of the aliased fields.
+	// default will use the rule field name. see WithFieldAliases
+	SourceKeyErrors bool
+	// DebugErrors Whether to record the source struct field of the errors, only for the struct data.
+	// the recorded field path and type can be got by ErrorSources()
+	DebugErrors bool
 	// CachingRules switch. default is False
 	// CachingRules bool
 
@@ -68,6 +139,10 @@ type Validation struct {
 	hasFiltered bool
 	// mark is validated
 	hasValidated bool
+	// the ctx of the running ValidateCtx, for interrupt the custom validators.
+	ctx context.Context
+	// mark the validate is interrupted by the ctx is done
+	ctxInterrupted bool
 	// validate rules for the validation
 	rules []*Rule
 
@@ -75,6 +150,10 @@ type Validation struct {
 	validators map[string]int8
 	// validator func meta info
 	validatorMetas map[string]*funcMeta
+	// disabled global validator names. see DisableValidator
+	disabledValidators map[string]bool
+	// validator names that failure as warning. see WarnOn
+	warnValidators map[string]bool
 
 	// current scene name
 	scene string
@@ -86,6 +165,14 @@ type Validation struct {
 	scenes SValues
 	// should check fields in current scene.
 	sceneFields map[string]uint8
+	// scene inheritance config. format: {"update": "create"}. see WithSceneParent
+	sceneParents map[string]string
+	// scenes exclude fields config. see WithSceneExcludes
+	sceneExcludes SValues
+	// should skip fields in current scene.
+	excludeFields map[string]uint8
+	// field mask paths, only validate these fields. see WithFieldMask
+	fieldMask []string
 
 	// filtering rules for the validation
 	filterRules []*FilterRule
@@ -94,6 +181,12 @@ type Validation struct {
 
 	// translator instance
 	trans *Translator
+	// parent validation, for nested validation. see WithParent
+	parent *Validation
+	// baseline data for check field is changed. see WithBaseline
+	baseline DataFace
+	// clock func for get the current time. see WithClock
+	clock func() time.Time
 }
 
 // NewEmpty new validation instance, but not with data.
@@ -113,12 +206,26 @@ func NewValidation(data DataFace, scene ...string) *Validation {
 // ResetResult reset the validate result.
 func (v *Validation) ResetResult() {
 	v.Errors = Errors{}
+	v.Warnings = Errors{}
 	v.hasError = false
 	v.hasFiltered = false
 	v.hasValidated = false
+	v.ctxInterrupted = false
 	// result data
 	v.SaferData = make(map[string]any)
 	v.filteredData = make(map[string]any)
+	v.coercedData = make(map[string]any)
+	v.parsedTimes = make(map[string]ParsedTime)
+	v.typedData = make(map[string]any)
+	v.checkedFields = nil
+	v.checkedSet = nil
+	v.msgArgs = nil
+	v.requiredFailed = nil
+	v.failedFields = nil
+	v.firstErr = nil
+	v.errSources = nil
+	v.errCodes = nil
+	v.errCodeSet = nil
 }
 
 // Reset the Validation instance.
@@ -149,6 +256,160 @@ func (v *Validation) WithSelf(fn func(v *Validation)) *Validation {
 	return v
 }
 
+// WithValueTransformer add a value transformer for the field.
+//
+// The fn is called just before each validator checks the field value,
+// the transformed value is only used for validating, will not save to
+// the source data, safe data and filtered data.
+//
+// Usage:
+//
+//	v.WithValueTransformer("name", func(val any) any {
+//		return strings.TrimSpace(val.(string))
+//	})
+func (v *Validation) WithValueTransformer(field string, fn func(val any) any) *Validation {
+	if v.valueTransformers == nil {
+		v.valueTransformers = make(map[string]func(val any) any)
+	}
+
+	v.valueTransformers[field] = fn
+	return v
+}
+
+// WithDefaultStringFilter set the default filter for all the string value fields.
+// it is applied on Filtering, before the field filter rules.
+//
+// Usage:
+//
+//	v.WithDefaultStringFilter("trim")
+func (v *Validation) WithDefaultStringFilter(name string) *Validation {
+	v.defStringFilter = name
+	return v
+}
+
+// WithFieldAliases set the field aliases, format: {rule field name: source key}.
+// the rules written against the field name will read data from the source key.
+//
+// The error field name is the rule field name by default, set SourceKeyErrors=true to use the source key.
+//
+// Usage:
+//
+//	v.WithFieldAliases(map[string]string{"email": "emailAddress"})
+//	v.StringRule("email", "required|email")
+func (v *Validation) WithFieldAliases(aliases map[string]string) *Validation {
+	if v.fieldAliases == nil {
+		v.fieldAliases = make(map[string]string, len(aliases))
+	}
+
+	for field, key := range aliases {
+		v.fieldAliases[field] = key
+	}
+	return v
+}
+
+// get the source data key of the field, resolve the field alias. support the top field of path. eg: "email.domain"
+func (v *Validation) sourceKey(field string) string {
+	if len(v.fieldAliases) == 0 {
+		return field
+	}
+
+	if key, ok := v.fieldAliases[field]; ok {
+		return key
+	}
+
+	if top, sub, ok := strings.Cut(field, "."); ok {
+		if key, ok := v.fieldAliases[top]; ok {
+			return key + "." + sub
+		}
+	}
+	return field
+}
+
+// WithParent set the parent validation for the nested validation.
+// then can reference the parent field by "../" path. eg: "../budget"
+//
+// NOTE: in one validation, the "../" path is resolved relative to the field path first.
+// eg: "items.0.price" with "lteField:../budget" will compare with "budget".
+//
+// Usage:
+//
+//	ov := validate.New(order)
+//	iv := validate.New(item).WithParent(ov)
+//	iv.StringRule("price", "lteField:../budget")
+func (v *Validation) WithParent(parent *Validation) *Validation {
+	v.parent = parent
+	return v
+}
+
+// WithClock set the clock func for get the current time, default is time.Now.
+// useful for the time related validators. eg: minAge, maxAge
+func (v *Validation) WithClock(fn func() time.Time) *Validation {
+	v.clock = fn
+	return v
+}
+
+// get the current time by the clock
+func (v *Validation) now() time.Time {
+	if v.clock != nil {
+		return v.clock()
+	}
+	return time.Now()
+}
+
+// WithBaseline set the baseline(old) data, use for ChangedOnly.
+// old allow: DataFace, map, struct(ptr). if old is nil or invalid, will check all fields.
+//
+// Usage:
+//
+//	v := validate.New(patch).WithBaseline(oldUser)
+//	v.ChangedOnly = true
+func (v *Validation) WithBaseline(old any) *Validation {
+	v.baseline = nil
+
+	switch td := old.(type) {
+	case nil:
+	case DataFace:
+		v.baseline = td
+	case M:
+		v.baseline = FromMap(td)
+	case map[string]any:
+		v.baseline = FromMap(td)
+	default:
+		if d, err := FromStruct(old); err == nil {
+			v.baseline = d
+		}
+	}
+	return v
+}
+
+// check the field value is not changed, compare with the baseline data.
+func (v *Validation) isUnchanged(field string) bool {
+	if v.baseline == nil {
+		return false
+	}
+
+	oldVal, ok := v.baseline.Get(field)
+	if !ok {
+		return false
+	}
+
+	val, exist := v.Raw(field)
+	if !exist {
+		return false
+	}
+
+	// normalize the numbers. eg: int(1) from struct and float64(1) from JSON
+	if num1, ok := numberAsFloat(val); ok {
+		if num2, ok := numberAsFloat(oldVal); ok {
+			return num1 == num2
+		}
+	}
+	return reflect.DeepEqual(val, oldVal)
+}
+
+// Parent get the parent validation. see WithParent
+func (v *Validation) Parent() *Validation { return v.parent }
+
 // WithTrans with a custom translator
 func (v *Validation) WithTrans(trans *Translator) *Validation {
 	v.trans = trans
@@ -174,6 +435,90 @@ func (v *Validation) WithScenes(scenes map[string][]string) *Validation {
 	return v
 }
 
+// WithSceneParent set the parent scene for the scene, the scene will inherit
+// the parent scene fields. will panic on the inheritance has cycle.
+//
+// Usage:
+//
+//	v.WithScenes(SValues{
+//		"create": []string{"name", "email"},
+//		"update": []string{"id"},
+//	})
+//	v.WithSceneParent("update", "create")
+//	// the update scene fields: id, name, email
+//	ok := v.AtScene("update").Validate()
+func (v *Validation) WithSceneParent(scene, parent string) *Validation {
+	for name := parent; name != ""; name = v.sceneParents[name] {
+		if name == scene {
+			panicf("the scene '%s' inheritance has cycle with the parent '%s'", scene, parent)
+		}
+	}
+
+	if v.sceneParents == nil {
+		v.sceneParents = make(map[string]string)
+	}
+	v.sceneParents[scene] = parent
+	return v
+}
+
+// WithSceneExcludes set the scene exclude fields config.
+// on the current scene is matched, will skip validate the fields, even if it has rules.
+//
+// Usage:
+//
+//	v.WithSceneExcludes(SValues{
+//		"update": []string{"password"},
+//	})
+//	ok := v.AtScene("update").Validate()
+func (v *Validation) WithSceneExcludes(excludes map[string][]string) *Validation {
+	v.sceneExcludes = excludes
+	return v
+}
+
+// WithFieldMask set the field mask paths, like gRPC field_mask.
+// will only validate the fields in the mask, it will override the scene fields.
+//
+// On validate, the unknown mask paths will be reported as "_validate" error.
+//
+// Usage:
+//
+//	v.WithFieldMask([]string{"Name", "Profile.City"})
+func (v *Validation) WithFieldMask(paths []string) *Validation {
+	v.fieldMask = paths
+	return v
+}
+
+// check the field mask paths is known. the path should be a rule field or a field of the struct.
+func (v *Validation) checkFieldMask() bool {
+	ruleFields := make(map[string]bool)
+	for _, rule := range v.rules {
+		for _, field := range rule.fields {
+			ruleFields[field] = true
+		}
+	}
+
+	sd, isStruct := v.data.(*StructData)
+
+	var unknown []string
+	for _, path := range v.fieldMask {
+		if ruleFields[path] {
+			continue
+		}
+		if isStruct {
+			if _, ok := sd.fieldType(path); ok {
+				continue
+			}
+		}
+		unknown = append(unknown, path)
+	}
+
+	if len(unknown) > 0 {
+		v.AddErrorf(validateError, "unknown field mask paths: %s", strings.Join(unknown, ", "))
+		return false
+	}
+	return true
+}
+
 // AtScene setting current validate scene.
 func (v *Validation) AtScene(scene string) *Validation {
 	v.scene = scene
@@ -185,6 +530,21 @@ func (v *Validation) InScene(scene string) *Validation {
 	return v.AtScene(scene)
 }
 
+// SceneFromRequest setting current validate scene by the request method.
+// the method match is case-insensitive, will keep current scene on not matched.
+//
+// Usage:
+//
+//	v.SceneFromRequest(r, map[string]string{"POST": "create", "PATCH": "update"})
+func (v *Validation) SceneFromRequest(r *http.Request, methods map[string]string) *Validation {
+	for method, scene := range methods {
+		if strings.EqualFold(method, r.Method) {
+			return v.AtScene(scene)
+		}
+	}
+	return v
+}
+
 // SetScene alias of the AtScene()
 func (v *Validation) SetScene(scene ...string) *Validation {
 	if len(scene) > 0 {
@@ -206,6 +566,7 @@ func (v *Validation) AddValidators(m map[string]any) *Validation {
 }
 
 // AddValidator to the Validation instance. checkFunc must return a bool.
+// the checkFunc can receive the validation as first arg. like: func(v *Validation, val any) bool
 //
 // Usage:
 //
@@ -223,6 +584,27 @@ func (v *Validation) AddValidator(name string, checkFunc any) *Validation {
 	return v
 }
 
+// DisableValidator disable the global(built-in) validator for current validation.
+// the validator aliases will not map to the disabled validator, so can register a
+// custom validator with same name to replace it.
+//
+// Usage:
+//
+//	v.DisableValidator("url")
+//	v.AddValidator("url", func(val string) bool {
+//		// do strict validate val ...
+//		return true
+//	})
+func (v *Validation) DisableValidator(name string) *Validation {
+	if v.disabledValidators == nil {
+		v.disabledValidators = make(map[string]bool)
+	}
+
+	v.disabledValidators[name] = true
+	v.disabledValidators[ValidatorName(name)] = true
+	return v
+}
+
 // ValidatorMeta get by name. get validator from global or validation instance.
 func (v *Validation) validatorMeta(name string) *funcMeta {
 	// current validation
@@ -231,7 +613,7 @@ func (v *Validation) validatorMeta(name string) *funcMeta {
 	}
 
 	// from global validators
-	if fm, ok := validatorMetas[name]; ok {
+	if fm, ok := validatorMetas[name]; ok && !v.disabledValidators[name] {
 		return fm
 	}
 
@@ -294,8 +676,20 @@ func (v *Validation) Filtering() bool {
 		return v.IsSuccess()
 	}
 
+	// apply the default filter for all string fields.
+	if v.defStringFilter != "" {
+		if fields := v.stringFields(); len(fields) > 0 {
+			if err := newFilterRule(fields).AddFilters(v.defStringFilter).Apply(v); err != nil {
+				v.AddError(filterError, filterError, err.Error())
+				v.hasFiltered = true
+				return v.IsSuccess()
+			}
+		}
+	}
+
 	// apply rule to validate data.
 	for _, rule := range v.filterRules {
+		// on CollectFilterErrors=true, the errors has been recorded by rule.Apply()
 		if err := rule.Apply(v); err != nil { // has error
 			v.AddError(filterError, filterError, rule.fields[0]+": "+err.Error())
 			break
@@ -342,6 +736,17 @@ func (v *Validation) WithMessages(m map[string]string) *Validation {
 	return v
 }
 
+// WithLocale use the locale messages loaded by LoadLocaleMessages(). will panic on the locale is not loaded.
+func (v *Validation) WithLocale(locale string) *Validation {
+	mp, ok := localeMessages[locale]
+	if !ok {
+		panicf("the locale '%s' messages is not loaded", locale)
+	}
+
+	v.trans.AddMessages(mp)
+	return v
+}
+
 // AddMessages settings data. like WithMessages()
 func (v *Validation) AddMessages(m map[string]string) {
 	v.trans.AddMessages(m)
@@ -361,8 +766,120 @@ func (v *Validation) AddError(field, validator, msg string) {
 		v.hasError = true
 	}
 
-	field = v.trans.FieldName(field)
-	v.Errors.Add(field, validator, msg)
+	if v.SourceKeyErrors {
+		field = v.sourceKey(field)
+	}
+
+	outName := v.trans.FieldName(field)
+	if v.firstErr == nil {
+		v.firstErr = &FieldError{Field: outName, Validator: validator, Message: msg}
+	}
+	if v.DebugErrors {
+		v.addErrorSource(outName, field)
+	}
+	v.addErrorCode(newFieldCode(outName, validator))
+	v.Errors.Add(outName, validator, msg)
+}
+
+// record the error code, skip the exists code.
+func (v *Validation) addErrorCode(fc FieldCode) {
+	if _, ok := v.errCodeSet[fc]; ok {
+		return
+	}
+
+	if v.errCodeSet == nil {
+		v.errCodeSet = make(map[FieldCode]struct{})
+	}
+	v.errCodeSet[fc] = struct{}{}
+	v.errCodes = append(v.errCodes, fc)
+}
+
+// ErrorCodes get the error codes in the order of added. see Errors.Codes
+//
+// Usage:
+//
+//	v.StringRule("name", "required")
+//	v.Validate()
+//	v.ErrorCodes() // [{Field: "name", Code: "name.required"}]
+func (v *Validation) ErrorCodes() []FieldCode {
+	return v.errCodes
+}
+
+// record the source struct field of the error.
+func (v *Validation) addErrorSource(outName, field string) {
+	sd, ok := v.data.(*StructData)
+	if !ok {
+		return
+	}
+
+	typ, ok := sd.fieldType(field)
+	if !ok {
+		return
+	}
+
+	if v.errSources == nil {
+		v.errSources = make(map[string]FieldSource)
+	}
+	v.errSources[outName] = FieldSource{
+		Path: strutil.UpperFirst(field),
+		Type: typ.String(),
+		Kind: removeTypePtr(typ).Kind(),
+	}
+}
+
+// ErrorSources get the source struct fields of the errors, the key is the error field name.
+// it is only recorded on DebugErrors is true, and the data is struct.
+//
+// Usage:
+//
+//	v.DebugErrors = true
+//	v.Validate()
+//	src := v.ErrorSources()["home.city"] // {Path: "Home.City", Type: "string", Kind: reflect.String}
+func (v *Validation) ErrorSources() map[string]FieldSource {
+	return v.errSources
+}
+
+// ParsedTime the parsed time and the matched layout of the dateTime validator.
+type ParsedTime struct {
+	Time   time.Time
+	Layout string
+}
+
+// ParsedTime get the parsed time and the matched layout of the field by the dateTime validator.
+//
+// Usage:
+//
+//	v.StringRule("startAt", "datetime:2006-01-02;RFC3339")
+//	v.Validate()
+//	pt, ok := v.ParsedTime("startAt") // pt.Layout is time.RFC3339
+func (v *Validation) ParsedTime(field string) (ParsedTime, bool) {
+	pt, ok := v.parsedTimes[field]
+	return pt, ok
+}
+
+// FormatErrors format the errors by the formatter.
+//
+// Usage:
+//
+//	v.FormatErrors(validate.MapErrorFormatter{})
+//	v.FormatErrors(validate.SliceErrorFormatter{})
+func (v *Validation) FormatErrors(f ErrorFormatter) any {
+	return f.Format(v.Errors)
+}
+
+// FirstError returns the first added field error, if no error returns nil.
+// Unlike Errors.OneError(), the result is stable: it is the error of the first failed rule.
+//
+// Usage:
+//
+//	if err := v.FirstError(); err != nil {
+//		return err
+//	}
+func (v *Validation) FirstError() error {
+	if v.firstErr == nil {
+		return nil
+	}
+	return v.firstErr
 }
 
 // AddErrorf add a formatted error message
@@ -370,6 +887,172 @@ func (v *Validation) AddErrorf(field, msgFormat string, args ...any) {
 	v.AddError(field, validateError, fmt.Sprintf(msgFormat, args...))
 }
 
+// WarnOn mark the validators as warning severity. on the validator check fail,
+// will add a warning instead of an error, so it does not affect the validate result.
+//
+// Usage:
+//
+//	v.StringRule("password", "required|minLen:6|hasUpperCase")
+//	v.WarnOn("hasUpperCase")
+//	ok := v.Validate() // ok is true, but has warning: v.Warnings
+func (v *Validation) WarnOn(validators ...string) *Validation {
+	if v.warnValidators == nil {
+		v.warnValidators = make(map[string]bool, len(validators))
+	}
+
+	for _, name := range validators {
+		v.warnValidators[name] = true
+	}
+	return v
+}
+
+// AddWarning message for a field. it does not affect the validate result.
+func (v *Validation) AddWarning(field, validator, msg string) {
+	field = v.trans.FieldName(field)
+	v.Warnings.Add(field, validator, msg)
+}
+
+// add the rule check fail message, as warning if the validator mark by WarnOn.
+// returns false on added as warning.
+func (v *Validation) addRuleError(r *Rule, field string) (isErr bool) {
+	msg := r.errorMessage(field, r.validator, v)
+	if v.warnValidators[r.validator] || v.warnValidators[r.realName] {
+		v.AddWarning(field, r.validator, msg)
+		return false
+	}
+
+	v.AddError(field, r.validator, msg)
+	// the errors key maybe is the output name, so record the source field name.
+	if v.failedFields == nil {
+		v.failedFields = make(map[string]bool)
+	}
+	v.failedFields[field] = true
+
+	// mark the required check failed, will skip other validators for the field.
+	if !r.nameNotRequired {
+		if v.requiredFailed == nil {
+			v.requiredFailed = make(map[string]bool)
+		}
+		v.requiredFailed[field] = true
+	}
+	return true
+}
+
+// ErrorsByValidator get the failed fields grouped by the validator name.
+// the validator alias name will be converted to the real name. eg: "minLen" -> "minLength"
+//
+// Returns like:
+//
+//	{"required": ["age", "name"], "email": ["email"]}
+func (v *Validation) ErrorsByValidator() map[string][]string {
+	groups := make(map[string][]string)
+	for field, fe := range v.Errors {
+		for validator := range fe {
+			name := ValidatorName(validator)
+			groups[name] = append(groups[name], field)
+		}
+	}
+
+	for _, fields := range groups {
+		sort.Strings(fields)
+	}
+	return groups
+}
+
+// PassedFields get the fields that had at least one rule run and no error.
+func (v *Validation) PassedFields() []string {
+	fields := make([]string, 0, len(v.checkedFields))
+	for _, field := range v.checkedFields {
+		if !v.Errors.HasField(v.trans.FieldName(field)) {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// the message key and args recorded by the failed validator
+type messageArgs struct {
+	// the message key, default is the validator name
+	key  string
+	args []any
+}
+
+// record the args of the error message on the validator is failed. see Rule.errorMessage
+func (v *Validation) setMessageArgs(field string, args ...any) {
+	v.setMessageKey(field, "", args...)
+}
+
+// record the message key and args of the error message on the validator is failed.
+func (v *Validation) setMessageKey(field, key string, args ...any) {
+	// no data source: it is the shared validation of the Val()
+	if v.data == nil {
+		return
+	}
+
+	if v.msgArgs == nil {
+		v.msgArgs = make(map[string]messageArgs)
+	}
+	v.msgArgs[field] = messageArgs{key: key, args: args}
+}
+
+// mark the field has been checked by rule
+func (v *Validation) markChecked(field string) {
+	if _, ok := v.checkedSet[field]; ok {
+		return
+	}
+
+	if v.checkedSet == nil {
+		v.checkedSet = make(map[string]struct{})
+	}
+	v.checkedSet[field] = struct{}{}
+	v.checkedFields = append(v.checkedFields, field)
+}
+
+// EachError walk all errors by the fn. the errors of rules are ordered by the rules,
+// then the other errors(eg: "_validate", "_filter") in random order.
+//
+// Usage:
+//
+//	v.EachError(func(field, validator, msg string) {
+//		fmt.Println(field, validator, msg)
+//	})
+func (v *Validation) EachError(fn func(field, validator, msg string)) {
+	if len(v.Errors) == 0 {
+		return
+	}
+
+	// the first rule index of the field and validator, build once for the lookup.
+	type fieldRule struct{ field, validator string }
+	firstIdx := make(map[fieldRule]int, len(v.rules))
+	for i, r := range v.rules {
+		for _, field := range r.fields {
+			key := fieldRule{v.trans.FieldName(field), r.validator}
+			if _, ok := firstIdx[key]; !ok {
+				firstIdx[key] = i
+			}
+		}
+	}
+
+	// errors of the rules
+	for i, r := range v.rules {
+		for _, field := range r.fields {
+			field = v.trans.FieldName(field)
+			if msg, ok := v.Errors[field][r.validator]; ok && firstIdx[fieldRule{field, r.validator}] == i {
+				fn(field, r.validator, msg)
+			}
+		}
+	}
+
+	// other errors
+	for field, fe := range v.Errors {
+		for validator, msg := range fe {
+			if _, ok := firstIdx[fieldRule{field, validator}]; !ok {
+				fn(field, validator, msg)
+			}
+		}
+	}
+}
+
 // Trans get translator
 func (v *Validation) Trans() *Translator {
 	// if v.trans == nil {
@@ -391,7 +1074,7 @@ func (v *Validation) Raw(key string) (any, bool) {
 	if v.data == nil { // check input data
 		return nil, false
 	}
-	return v.data.Get(key)
+	return v.data.Get(v.sourceKey(key))
 }
 
 // RawVal value get by key
@@ -399,7 +1082,7 @@ func (v *Validation) RawVal(key string) any {
 	if v.data == nil { // check input data
 		return nil
 	}
-	val, _ := v.data.Get(key)
+	val, _ := v.data.Get(v.sourceKey(key))
 	return val
 }
 
@@ -409,6 +1092,14 @@ func (v *Validation) RawVal(key string) any {
 //
 // If v.data is StructData, will return zero value check. Other dataSource will always return `zero=False`.
 func (v *Validation) tryGet(key string) (val any, exist, zero bool) {
+	// get from parent validation. eg: "../budget"
+	if strings.HasPrefix(key, "../") {
+		if v.parent == nil {
+			return
+		}
+		return v.parent.tryGet(key[3:])
+	}
+
 	if v.data == nil {
 		return
 	}
@@ -425,7 +1116,7 @@ func (v *Validation) tryGet(key string) (val any, exist, zero bool) {
 
 	// TODO add cache data v.caches[key]
 	// get from source data
-	return v.data.TryGet(key)
+	return v.data.TryGet(v.sourceKey(key))
 }
 
 // Get value by key.
@@ -525,6 +1216,9 @@ func (v *Validation) BindSafeData(ptr interface{}) (int, error) {
 func (v *Validation) Set(field string, val any) error {
 	// check input data
 	if v.data == nil {
+		if v.AllowNilData {
+			return nil
+		}
 		return ErrEmptyData
 	}
 
@@ -536,7 +1230,7 @@ func (v *Validation) Set(field string, val any) error {
 func (v *Validation) updateValue(field string, val any) (any, error) {
 	// data source is struct
 	if v.data.Type() == sourceStruct {
-		return v.data.Set(strings.TrimSuffix(field, ".*"), val)
+		return v.data.Set(strings.TrimSuffix(v.sourceKey(field), ".*"), val)
 	}
 
 	// TODO dont update value for Form and Map data source
@@ -559,7 +1253,24 @@ func (v *Validation) GetDefValue(field string) (any, bool) {
 
 // SceneFields field names get
 func (v *Validation) SceneFields() []string {
-	return v.scenes[v.scene]
+	return v.sceneFieldsOf(v.scene)
+}
+
+// get the scene fields, contains the inherited parent scene fields.
+func (v *Validation) sceneFieldsOf(scene string) []string {
+	parent, ok := v.sceneParents[scene]
+	if !ok {
+		return v.scenes[scene]
+	}
+
+	// copy the parent fields, avoid append to the parent scene config.
+	fields := append([]string(nil), v.sceneFieldsOf(parent)...)
+	for _, field := range v.scenes[scene] {
+		if !arrutil.StringsHas(fields, field) {
+			fields = append(fields, field)
+		}
+	}
+	return fields
 }
 
 // scene field name map build
@@ -568,7 +1279,7 @@ func (v *Validation) sceneFieldMap() (m map[string]uint8) {
 		return
 	}
 
-	if fields, ok := v.scenes[v.scene]; ok {
+	if fields := v.sceneFieldsOf(v.scene); len(fields) > 0 {
 		m = make(map[string]uint8, len(fields))
 		for _, field := range fields {
 			m[field] = 1
@@ -592,6 +1303,24 @@ func (v *Validation) IsSuccess() bool { return !v.hasError }
 // SafeData get all validated safe data
 func (v *Validation) SafeData() M { return v.SaferData }
 
+// TypedData get a new map of the validated safe data, the values checked by the type
+// validators are stored in their native Go types. eg: "12" with rule "int8" -> int8(12)
+//
+// Unlike the StoreCoerced, the safe data will not be changed.
+func (v *Validation) TypedData() M {
+	data := make(M, len(v.SaferData))
+	for field, val := range v.SaferData {
+		data[field] = val
+	}
+
+	for field, val := range v.typedData {
+		if _, ok := data[field]; ok {
+			data[field] = val
+		}
+	}
+	return data
+}
+
 // FilteredData return filtered data.
 func (v *Validation) FilteredData() M {
 	return v.filteredData
@@ -606,7 +1335,30 @@ func (v *Validation) shouldStop() bool {
 	return v.hasError && v.StopOnError
 }
 
+func (v *Validation) sceneExcludeMap() (m map[string]uint8) {
+	if v.scene == "" {
+		return
+	}
+
+	if fields, ok := v.sceneExcludes[v.scene]; ok {
+		m = make(map[string]uint8, len(fields))
+		for _, field := range fields {
+			m[field] = 1
+		}
+	}
+	return
+}
+
 func (v *Validation) isNotNeedToCheck(field string) bool {
+	if len(v.excludeFields) > 0 {
+		fields := strings.Split(field, ".")
+		for i := 1; i <= len(fields); i++ {
+			if _, ok := v.excludeFields[strings.Join(fields[0:i], ".")]; ok {
+				return true
+			}
+		}
+	}
+
 	if len(v.sceneFields) == 0 {
 		return false
 	}
@@ -3,10 +3,14 @@ package validate
 import (
 	"bytes"
 	"fmt"
+	"image"
+	"image/png"
 	"mime/multipart"
 	"net/http"
 	"net/url"
+	"reflect"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -221,7 +225,10 @@ func TestStruct(t *testing.T) {
 	is.False(ok)
 	is.Equal("User Name min length is 7", v.Errors.FieldOne("Name"))
 	is.Equal("oh! the UpdateAt is required", v.Errors.FieldOne("UpdateAt"))
-	is.Empty(v.SafeData())
+	// only the passed fields in the safe data
+	is.NotContains(v.SafeData(), "Name")
+	is.NotContains(v.SafeData(), "UpdateAt")
+	is.Contains(v.SafeData(), "Email")
 	is.Empty(v.FilteredData())
 
 	u.Name = "new name"
@@ -310,6 +317,49 @@ func TestJSON(t *testing.T) {
 	is.Equal("inhere", v.SafeData()["name"])
 }
 
+func TestValidation_DebugErrors(t *testing.T) {
+	is := assert.New(t)
+
+	type address struct {
+		City string `json:"city" validate:"required"`
+	}
+	type user struct {
+		Name string   `json:"name" validate:"required"`
+		Age  *int     `json:"age" validate:"required"`
+		Tags []string `json:"tags" validate:"minLen:2"`
+		Home address  `json:"home"`
+	}
+
+	u := &user{Tags: []string{"a"}}
+	v := Struct(u)
+	v.StopOnError = false
+	is.False(v.Validate())
+	// default is off
+	is.Nil(v.ErrorSources())
+
+	v = Struct(u)
+	v.StopOnError = false
+	v.DebugErrors = true
+	is.False(v.Validate())
+
+	srcs := v.ErrorSources()
+	is.Len(srcs, 4)
+	is.Equal(FieldSource{Path: "Name", Type: "string", Kind: reflect.String}, srcs["name"])
+	is.Equal(FieldSource{Path: "Age", Type: "*int", Kind: reflect.Int}, srcs["age"])
+	is.Equal(FieldSource{Path: "Tags", Type: "[]string", Kind: reflect.Slice}, srcs["tags"])
+	is.Equal(FieldSource{Path: "Home.City", Type: "string", Kind: reflect.String}, srcs["home.city"])
+
+	v.ResetResult()
+	is.Nil(v.ErrorSources())
+
+	// not a struct data
+	v = New(M{"name": ""})
+	v.DebugErrors = true
+	v.StringRule("name", "required")
+	is.False(v.Validate())
+	is.Nil(v.ErrorSources())
+}
+
 func TestFromQuery(t *testing.T) {
 	is := assert.New(t)
 	data := url.Values{
@@ -330,7 +380,7 @@ func TestFromQuery(t *testing.T) {
 	is.Equal("inhere", val)
 	is.False(v.Validate())
 	is.Equal("name min length is 7", v.Errors.FieldOne("name"))
-	is.Empty(v.SafeData())
+	is.Equal(M{"age": 10}, v.SafeData())
 
 	v = FromQuery(data).Validation(fmt.Errorf("an error"))
 	is.Equal("an error", v.Errors.One())
@@ -469,6 +519,55 @@ func TestFromRequest_FileForm(t *testing.T) {
 	}, "validate: not enough parameters for validator 'mimes'!")
 }
 
+func TestImageDimensions(t *testing.T) {
+	is := assert.New(t)
+
+	// =================== POST: image file form ===================
+	buf := new(bytes.Buffer)
+	mw := multipart.NewWriter(buf)
+	w, err := mw.CreateFormFile("avatar", "avatar.png")
+	if is.NoErr(err).IsOk() {
+		// write a 10x20 png image
+		is.NoErr(png.Encode(w, image.NewRGBA(image.Rect(0, 0, 10, 20))))
+	}
+	_ = mw.Close()
+
+	r, _ := http.NewRequest(http.MethodPost, "/users", buf)
+	r.Header.Set("Content-Type", mw.FormDataContentType())
+	d, err := FromRequest(r, defaultMaxMemory)
+	is.NoErr(err)
+	fd := d.(*FormData)
+
+	cfg, err := fd.FileImageConfig("avatar")
+	is.NoErr(err)
+	is.Equal(10, cfg.Width)
+	is.Equal(20, cfg.Height)
+	_, err = fd.FileImageConfig("not-exist")
+	is.Err(err)
+
+	v := d.Validation()
+	is.True(v.ImageDimensions(fd, "avatar"))
+	is.True(v.ImageDimensions(fd, "avatar", "w=10", "h=20"))
+	is.True(v.ImageDimensions(fd, "avatar", "minW=10", "maxW=10", "minH=1", "maxH=512"))
+	is.False(v.ImageDimensions(fd, "avatar", "maxH=10"))
+	is.False(v.ImageDimensions(fd, "avatar", "minW=11"))
+	is.False(v.ImageDimensions(fd, "not-exist", "maxW=512"))
+	is.PanicsMsg(func() {
+		v.ImageDimensions(fd, "avatar", "maxW")
+	}, "validate: invalid image dimensions limit 'maxW', format must be 'key=value'")
+	is.PanicsMsg(func() {
+		v.ImageDimensions(fd, "avatar", "size=10")
+	}, "validate: invalid image dimensions limit key 'size'")
+
+	v.StringRule("avatar", "required|dimensions:maxW=512,maxH=512")
+	is.True(v.Validate())
+
+	v = d.Validation()
+	v.StringRule("avatar", "dimensions:minW=64")
+	is.False(v.Validate())
+	is.Equal("avatar image dimensions must match the limits [minW=64]", v.Errors.One())
+}
+
 func TestFromRequest_JSON(t *testing.T) {
 	// =================== POST: JSON body ===================
 	body := `{
@@ -642,6 +741,143 @@ func TestValidationScene(t *testing.T) {
 	is.Equal("name min length is 7", v.Errors.One())
 }
 
+func TestValidation_SceneFromRequest(t *testing.T) {
+	is := assert.New(t)
+	methods := map[string]string{"POST": "create", "patch": "update"}
+
+	v := New(M{"id": 0, "name": "in"})
+	v.StringRules(MS{"id": "required", "name": "minLen:3"})
+	v.WithScenes(SValues{
+		"create": []string{"name"},
+		"update": []string{"id", "name"},
+	})
+
+	r, _ := http.NewRequest(http.MethodPatch, "/users/23", nil)
+	v.SceneFromRequest(r, methods)
+	is.Equal("update", v.Scene())
+	is.False(v.Validate())
+	is.True(v.Errors.HasField("id"))
+
+	r, _ = http.NewRequest(http.MethodPost, "/users", nil)
+	v.ResetResult()
+	v.SceneFromRequest(r, methods)
+	is.Equal("create", v.Scene())
+	is.False(v.Validate())
+	is.False(v.Errors.HasField("id"))
+	is.True(v.Errors.HasField("name"))
+
+	// not matched, keep current scene
+	r, _ = http.NewRequest(http.MethodGet, "/users", nil)
+	is.Equal("create", v.SceneFromRequest(r, methods).Scene())
+}
+
+func TestValidation_WithSceneParent(t *testing.T) {
+	is := assert.New(t)
+
+	v := New(M{"id": 23, "name": "inhere"})
+	v.WithScenes(SValues{
+		"create": []string{"name", "email"},
+		"update": []string{"id"},
+		"patch":  []string{"version", "name"},
+	})
+	v.WithSceneParent("update", "create").WithSceneParent("patch", "update")
+
+	v.SetScene("update")
+	is.Equal([]string{"name", "email", "id"}, v.SceneFields())
+	// two-level inheritance
+	v.SetScene("patch")
+	is.Equal([]string{"name", "email", "id", "version"}, v.SceneFields())
+	// the parent scene config is not changed
+	v.SetScene("create")
+	is.Equal([]string{"name", "email"}, v.SceneFields())
+
+	v.StringRules(MS{"name": "required", "email": "required", "version": "required", "age": "required"})
+	is.False(v.AtScene("patch").Validate())
+	is.True(v.Errors.HasField("email"))
+	is.False(v.Errors.HasField("age"))
+
+	// cycle
+	is.PanicsMsg(func() {
+		v.WithSceneParent("create", "patch")
+	}, "validate: the scene 'create' inheritance has cycle with the parent 'patch'")
+	is.Panics(func() {
+		v.WithSceneParent("create", "create")
+	})
+}
+
+func TestValidation_WithSceneExcludes(t *testing.T) {
+	is := assert.New(t)
+	mp := M{
+		"name":     "inhere",
+		"password": "",
+		"profile":  M{"city": ""},
+	}
+
+	v := Map(mp)
+	v.StringRules(MS{
+		"name":         "required|minLen:2",
+		"password":     "required|minLen:6",
+		"profile.city": "required",
+	})
+	v.WithSceneExcludes(SValues{
+		"update": []string{"password", "profile"},
+	})
+
+	// on scene "create"
+	is.False(v.Validate("create"))
+	is.True(v.Errors.HasField("password"))
+	is.True(v.Errors.HasField("profile.city"))
+
+	// on scene "update"
+	v.ResetResult()
+	is.True(v.Validate("update"))
+	is.True(v.Errors.Empty())
+
+	// with scenes config
+	v = Map(mp)
+	v.StringRules(MS{
+		"name":     "required|minLen:2",
+		"password": "required|minLen:6",
+	})
+	v.WithScenes(SValues{"update": []string{"name", "password"}})
+	v.WithSceneExcludes(SValues{"update": []string{"password"}})
+	is.True(v.Validate("update"))
+}
+
+func TestValidation_DisableValidator(t *testing.T) {
+	is := assert.New(t)
+	data := M{"site": "github.com", "home": "https://github.com"}
+
+	// built-in url is loose
+	v := New(data)
+	v.StringRule("site", "url")
+	is.True(v.Validate())
+
+	v = New(data)
+	v.DisableValidator("url")
+	v.AddValidator("url", func(val string) bool {
+		return strings.HasPrefix(val, "https://")
+	})
+	v.StringRule("site", "url")
+	v.StringRule("home", "url")
+	is.False(v.Validate())
+	is.True(v.Errors.HasField("site"))
+	is.False(v.Errors.HasField("home"))
+
+	// disabled and not register custom
+	v = New(data)
+	v.DisableValidator("isURL")
+	v.StringRule("site", "isURL")
+	is.PanicsMsg(func() {
+		v.Validate()
+	}, "validate: the validator 'isURL' does not exist")
+
+	// not affect the other validation
+	v = New(data)
+	v.StringRule("site", "url")
+	is.True(v.Validate())
+}
+
 func TestAddValidator(t *testing.T) {
 	is := assert.New(t)
 
@@ -704,6 +940,42 @@ func TestAddValidator(t *testing.T) {
 	is.Contains(v.Validators(true), "min")
 }
 
+func TestAddValidator_withValidation(t *testing.T) {
+	is := assert.New(t)
+
+	lessThanEnd := func(v *Validation, val any) bool {
+		end, _ := v.Get("end")
+		return Lt(val, end)
+	}
+
+	v := New(M{"start": 3, "end": 5})
+	v.AddValidator("lessThanEnd", lessThanEnd)
+	v.StringRule("start", "lessThanEnd")
+	is.True(v.Validate())
+
+	v = New(M{"start": 8, "end": 5})
+	v.AddValidator("lessThanEnd", lessThanEnd)
+	v.StringRule("start", "lessThanEnd")
+	is.False(v.Validate())
+	is.True(v.Errors.HasField("start"))
+
+	// with extra args, on current validation
+	hasFieldPrefix := func(v *Validation, val string, field string, minLen int) bool {
+		prefix, _ := v.Get(field)
+		return len(val) >= minLen && strings.HasPrefix(val, prefix.(string))
+	}
+
+	v = New(M{"name": "tom", "prefix": "to"})
+	v.AddValidator("hasFieldPrefix", hasFieldPrefix)
+	v.StringRule("name", "hasFieldPrefix:prefix,3")
+	is.True(v.Validate())
+
+	v = New(M{"name": "tim", "prefix": "to"})
+	v.AddValidator("hasFieldPrefix", hasFieldPrefix)
+	v.StringRule("name", "hasFieldPrefix:prefix,3")
+	is.False(v.Validate())
+}
+
 func TestValidation_ValidateData(t *testing.T) {
 	d := FromMap(M{
 		"name": "inhere",
@@ -764,6 +1036,30 @@ func TestGetSet_OnNilData(t *testing.T) {
 	is.Equal("check failed", v.Errors.Random())
 }
 
+func TestValidation_AllowNilData(t *testing.T) {
+	is := assert.New(t)
+
+	v := NewEmpty()
+	is.Eq(ErrEmptyData, v.Set("name", "inhere"))
+
+	// custom new
+	v = &Validation{Errors: make(Errors)}
+	is.Eq(ErrEmptyData, v.Set("age", 12))
+	v.AllowNilData = true
+	is.NoErr(v.Set("age", 12))
+	is.Nil(v.RawVal("age"))
+
+	v = NewEmpty()
+	v.AllowNilData = true
+	v.SetDefValue("name", "inhere")
+	is.NoErr(v.Set("name", "tom"))
+
+	// attach data later
+	v.StringRule("name", "required")
+	is.True(v.ValidateData(FromMap(M{"age": 23})))
+	is.Eq("inhere", v.SafeVal("name"))
+}
+
 func TestBuiltInValidators(t *testing.T) {
 	is := assert.New(t)
 	v := New(M{"age": "12"})
@@ -1075,3 +1371,426 @@ func TestValidation_GteField(t *testing.T) {
 	ok := v.GteField(ts.End, "start")
 	assert.False(t, ok)
 }
+
+func TestNewFrom(t *testing.T) {
+	is := assert.New(t)
+
+	v := NewEmpty()
+	v.StopOnError = false
+	v.StringRule("name", "required|minLen:3", "trim")
+	v.StringRule("age", "required|int|between:1,100")
+	v.AddValidator("isCode", func(val string) bool {
+		return strings.HasPrefix(val, "C")
+	})
+	v.StringRule("code", "isCode")
+	v.WithMessages(map[string]string{"code.isCode": "code must start with C"})
+	tpl := v.Prepare()
+
+	// later changes not affect the template
+	v.StringRule("email", "required")
+
+	nv := NewFrom(tpl, M{"name": " inhere ", "age": 23, "code": "C001"})
+	is.False(nv.StopOnError)
+	is.True(nv.Validate())
+	is.Equal("inhere", nv.Filtered("name"))
+
+	nv = NewFrom(tpl, M{"name": "in", "age": 230, "code": "X001"})
+	is.False(nv.Validate())
+	is.Equal("name min length is 3", nv.Errors.FieldOne("name"))
+	is.True(nv.Errors.HasField("age"))
+	is.Equal("code must start with C", nv.Errors.FieldOne("code"))
+	is.False(nv.Errors.HasField("email"))
+
+	// validate different data concurrently from one template
+	var wg sync.WaitGroup
+	results := make([]bool, 50)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			age := i + 1
+			if i%2 == 1 {
+				age = 200 + i
+			}
+
+			nv := NewFrom(tpl, M{"name": fmt.Sprint("user", i), "age": age, "code": "C01"})
+			results[i] = nv.Validate()
+		}(i)
+	}
+	wg.Wait()
+
+	for i, ok := range results {
+		is.Equal(i%2 == 0, ok, "index %d", i)
+	}
+}
+
+func TestValidateSlice(t *testing.T) {
+	is := assert.New(t)
+
+	type createUserReq struct {
+		Name  string `json:"name" validate:"required"`
+		Email string `json:"email" validate:"required|email"`
+	}
+
+	items := []createUserReq{
+		{Name: "tom", Email: "tom@example.com"},
+		{Name: "john", Email: "invalid"},
+		{Name: "jerry", Email: "jerry@example.com"},
+	}
+
+	vs, ok := ValidateSlice(items)
+	is.False(ok)
+	is.Len(vs, 3)
+	is.True(vs[0].IsOK())
+	is.True(vs[1].IsFail())
+	is.True(vs[2].IsOK())
+
+	es := SliceErrors(vs)
+	is.Len(es, 1)
+	is.True(es.HasField("[1].email"))
+	is.Eq("email value is an invalid email address", es.FieldOne("[1].email"))
+
+	// with extra rules
+	vs, ok = ValidateSlice([]M{{"age": 12}, {"age": 30}}, MS{"age": "required|max:20"})
+	is.False(ok)
+	is.True(SliceErrors(vs).HasField("[1].age"))
+
+	items[1].Email = "john@example.com"
+	_, ok = ValidateSlice(items)
+	is.True(ok)
+
+	is.Panics(func() {
+		ValidateSlice("invalid")
+	})
+}
+
+func TestValidateCSV(t *testing.T) {
+	is := assert.New(t)
+
+	records := [][]string{
+		{"name", "email", "age"},
+		{"inhere", "some@example.com", "23"},
+		{"", "invalid", "17"},
+	}
+	rules := MS{"name": "required", "email": "required|email", "age": "int|min:18"}
+
+	vs, ok := ValidateCSV(records, rules)
+	is.False(ok)
+	is.Len(vs, 2)
+	is.True(vs[0].IsOK())
+	is.Equal("some@example.com", vs[0].SafeVal("email"))
+	is.True(vs[1].IsFail())
+
+	es := SliceErrors(vs)
+	is.True(es.HasField("[1].name"))
+	is.False(es.HasField("[0].name"))
+
+	// short row, only header
+	vs, ok = ValidateCSV([][]string{{"name", "email"}, {"inhere"}}, MS{"email": "required"})
+	is.False(ok)
+	is.True(vs[0].Errors.HasField("email"))
+	vs, ok = ValidateCSV(records[:1], rules)
+	is.True(ok)
+	is.Empty(vs)
+}
+
+func TestValidation_EachError(t *testing.T) {
+	is := assert.New(t)
+
+	v := New(M{"name": "a", "age": 0, "email": "invalid"})
+	v.StringRules(MS{"name": "required|minLen:2"})
+	v.StringRule("age", "required|min:1")
+	v.StringRule("email", "email")
+	v.AddRule("name", "minLen", 3)
+	is.False(v.Validate())
+	v.AddError("_custom", "custom", "custom error")
+
+	var count, num int
+	var fields []string
+	v.EachError(func(field, validator, msg string) {
+		count++
+		fields = append(fields, field+"."+validator)
+		is.Equal(v.Errors[field][validator], msg)
+	})
+
+	for _, fe := range v.Errors {
+		num += len(fe)
+	}
+	is.Equal(num, count)
+	is.Equal([]string{"name.minLen", "age.required", "email.email", "_custom.custom"}, fields)
+
+	// no errors
+	v = New(M{"name": "inhere"})
+	v.StringRule("name", "required")
+	is.True(v.Validate())
+	v.EachError(func(field, validator, msg string) {
+		t.Fatal("should not be called")
+	})
+}
+
+func TestValidation_Warnings(t *testing.T) {
+	is := assert.New(t)
+
+	strongPwd := func(val string) bool {
+		return HasLowerCase(val) && HasUpperCase(val)
+	}
+
+	v := New(M{"name": "inhere", "password": "abc123"})
+	v.AddValidator("strongPwd", strongPwd)
+	v.StringRule("name", "required")
+	v.StringRule("password", "required|minLen:6|strongPwd")
+	v.WarnOn("strongPwd")
+	v.AddMessages(MS{"password.strongPwd": "password is weak but allowed"})
+
+	is.True(v.Validate())
+	is.True(v.IsOK())
+	is.Empty(v.Errors)
+	is.True(v.Warnings.HasField("password"))
+	is.Equal("password is weak but allowed", v.Warnings.FieldOne("password"))
+	is.Equal("abc123", v.SafeVal("password"))
+
+	// add warning manually
+	v.AddWarning("name", "custom", "name is too common")
+	is.True(v.IsOK())
+	is.Equal("name is too common", v.Warnings.FieldOne("name"))
+
+	// reset
+	v.ResetResult()
+	is.Empty(v.Warnings)
+
+	// error still reported
+	v = New(M{"password": "abc"})
+	v.AddValidator("strongPwd", strongPwd)
+	v.StringRule("password", "required|minLen:6|strongPwd")
+	v.WarnOn("strongPwd")
+	is.False(v.Validate())
+	is.True(v.Errors.HasField("password"))
+	is.True(v.Warnings.HasField("password"))
+}
+
+func TestValidation_WithFieldAliases(t *testing.T) {
+	is := assert.New(t)
+
+	data := M{"emailAddress": "invalid", "userName": "inhere", "profile": map[string]any{"homeCity": "Berlin"}}
+	v := New(data).WithFieldAliases(map[string]string{
+		"email": "emailAddress",
+		"name":  "userName",
+	})
+	v.StopOnError = false
+	v.StringRule("email", "required|email")
+	v.StringRule("name", "required|minLen:3")
+	v.WithMessages(map[string]string{"email.email": "{field} is not a valid email"})
+
+	is.Equal("inhere", v.RawVal("name"))
+	is.False(v.Validate())
+	// the error key use the alias
+	is.Equal("email is not a valid email", v.Errors.FieldOne("email"))
+	is.False(v.Errors.HasField("emailAddress"))
+	is.False(v.Errors.HasField("name"))
+	is.Equal("inhere", v.SafeVal("name"))
+
+	// use the source key for the error key
+	v = New(data).WithFieldAliases(map[string]string{"email": "emailAddress"})
+	v.SourceKeyErrors = true
+	v.StringRule("email", "required|email")
+	is.False(v.Validate())
+	is.True(v.Errors.HasField("emailAddress"))
+	is.False(v.Errors.HasField("email"))
+
+	// the alias of the top field in path
+	v = New(data).WithFieldAliases(map[string]string{"home": "profile"})
+	v.StringRule("home.homeCity", "required|in:Berlin")
+	is.True(v.Validate())
+}
+
+func TestValidation_WithParent(t *testing.T) {
+	is := assert.New(t)
+
+	type item struct {
+		Name     string `json:"name"`
+		MaxPrice int    `json:"max_price"`
+	}
+	type order struct {
+		Budget int    `json:"budget"`
+		Items  []item `json:"items"`
+	}
+
+	o := &order{Budget: 100, Items: []item{{"a", 80}, {"b", 120}}}
+	ov := Struct(o)
+	is.True(ov.Validate())
+
+	iv := Struct(&o.Items[0]).WithParent(ov)
+	is.Eq(ov, iv.Parent())
+	iv.StringRule("MaxPrice", "lteField:../Budget")
+	is.True(iv.Validate())
+
+	iv = Struct(&o.Items[1]).WithParent(ov)
+	iv.StringRule("MaxPrice", "lteField:../Budget")
+	is.False(iv.Validate())
+	is.True(iv.Errors.HasField("max_price"))
+
+	// resolved up the chain
+	root := New(M{"limit": 200})
+	mid := New(M{"budget": 100}).WithParent(root)
+	leaf := New(M{"price": 150}).WithParent(mid)
+	leaf.StringRule("price", "lteField:../../limit")
+	is.True(leaf.Validate())
+
+	val, ok := leaf.Get("../budget")
+	is.True(ok)
+	is.Eq(100, val)
+
+	// no parent
+	v := New(M{"price": 150})
+	_, ok = v.Get("../budget")
+	is.False(ok)
+	v.StringRule("price", "lteField:../budget")
+	is.False(v.Validate())
+}
+
+func TestValidation_relativeParentField(t *testing.T) {
+	is := assert.New(t)
+
+	// the wildcard field in one validation
+	v := New(map[string]any{
+		"budget": 100,
+		"items": []map[string]any{
+			{"name": "a", "max_price": 80},
+			{"name": "b", "max_price": 120},
+		},
+	})
+	v.StringRule("items.*.max_price", "lteField:../budget")
+	is.False(v.Validate())
+	is.True(v.Errors.HasField("items.*.max_price"))
+
+	v = New(map[string]any{"budget": 100, "items": []map[string]any{{"max_price": 80}}})
+	v.StringRule("items.0.max_price", "lteField:../budget")
+	is.True(v.Validate())
+
+	// the nested struct rules
+	type item struct {
+		Name     string
+		MaxPrice int `validate:"lteField:../Budget"`
+	}
+	type order struct {
+		Budget int
+		Items  []item
+		Main   struct {
+			Price int `validate:"ltField:../Budget"`
+		}
+	}
+
+	o := &order{Budget: 100, Items: []item{{"a", 80}}}
+	o.Main.Price = 90
+	v = Struct(o)
+	is.True(v.Validate())
+
+	o.Items = append(o.Items, item{"b", 120})
+	o.Main.Price = 100
+	v = Struct(o)
+	v.StopOnError = false
+	is.False(v.Validate())
+	is.True(v.Errors.HasField("Items.1.MaxPrice"))
+	is.True(v.Errors.HasField("Main.Price"))
+	is.False(v.Errors.HasField("Items.0.MaxPrice"))
+
+	is.Eq("budget", resolveRelField("items.0.price", "../budget"))
+	is.Eq("order.budget", resolveRelField("order.items.*.price", "../budget"))
+	is.Eq("../budget", resolveRelField("price", "../budget"))
+	is.Eq("../limit", resolveRelField("items.0.price", "../../limit"))
+	is.Eq("name", resolveRelField("items.0.price", "name"))
+}
+
+func TestValidation_ChangedOnly(t *testing.T) {
+	is := assert.New(t)
+
+	type user struct {
+		Name  string
+		Email string
+		Age   int
+	}
+
+	// the old email is invalid, but not changed
+	old := &user{Name: "inhere", Email: "invalid", Age: 20}
+	patch := M{"Name": "i", "Email": "invalid", "Age": 20}
+	rules := MS{"Name": "required|minLen:2", "Email": "email", "Age": "min:18"}
+
+	v := New(patch).WithBaseline(old)
+	v.ChangedOnly = true
+	v.StringRules(rules)
+	is.False(v.Validate())
+	is.True(v.Errors.HasField("Name"))
+	is.False(v.Errors.HasField("Email"))
+	is.Len(v.Errors, 1)
+
+	// not enable ChangedOnly
+	v = New(patch).WithBaseline(old)
+	v.StringRules(rules)
+	is.False(v.Validate())
+	is.True(v.Errors.HasField("Email"))
+
+	// nil baseline, will check all fields
+	v = New(patch).WithBaseline(nil)
+	v.ChangedOnly = true
+	v.StringRules(rules)
+	is.False(v.Validate())
+	is.True(v.Errors.HasField("Name"))
+	is.True(v.Errors.HasField("Email"))
+
+	// map baseline
+	v = New(M{"Email": "invalid", "Age": 21}).WithBaseline(M{"Email": "invalid", "Age": 20})
+	v.ChangedOnly = true
+	v.StringRules(MS{"Email": "email", "Age": "min:18"})
+	is.True(v.Validate())
+
+	// the number from JSON is float64, the struct baseline is int
+	v = New(M{"Name": "inhere", "Age": float64(16)}).WithBaseline(&user{Name: "inhere", Age: 16})
+	v.ChangedOnly = true
+	v.StringRules(MS{"Age": "min:18"})
+	is.True(v.Validate())
+
+	v = New(M{"Age": float64(16.5)}).WithBaseline(&user{Age: 16})
+	v.ChangedOnly = true
+	v.StringRules(MS{"Age": "min:18"})
+	is.False(v.Validate())
+}
+
+func TestValidation_WithFieldMask(t *testing.T) {
+	is := assert.New(t)
+
+	type profile struct {
+		City string `validate:"required"`
+	}
+	type user struct {
+		Name    string `validate:"required|minLen:2"`
+		Email   string `validate:"email"`
+		Profile profile
+	}
+
+	u := &user{Name: "inhere", Email: "invalid"}
+
+	// valid mask path, email is not validated
+	v := Struct(u).WithFieldMask([]string{"Name"})
+	is.True(v.Validate())
+
+	v = Struct(u).WithFieldMask([]string{"Name", "Profile.City"})
+	is.False(v.Validate())
+	is.True(v.Errors.HasField("Profile.City"))
+	is.False(v.Errors.HasField("Email"))
+
+	// unknown mask path
+	v = Struct(u).WithFieldMask([]string{"Name", "Nickname"})
+	is.False(v.Validate())
+	is.Equal("unknown field mask paths: Nickname", v.Errors.FieldOne("_validate"))
+	// has been validated, will not validate it again
+	is.True(v.hasValidated)
+	is.False(v.Validate())
+
+	// mask path is checked by the rules, not by the data
+	v = New(M{"age": 20}).WithFieldMask([]string{"name"})
+	v.StringRules(MS{"name": "required", "age": "min:30"})
+	is.False(v.Validate())
+	is.True(v.Errors.HasField("name"))
+	is.False(v.Errors.HasField("age"))
+	is.False(v.Errors.HasField("_validate"))
+}
@@ -2,20 +2,39 @@ package validate
 
 import (
 	"bytes"
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"hash"
+	"io"
+	"math"
+	"math/big"
+	"mime"
 	"net"
 	"net/url"
+	"path"
+	"path/filepath"
 	"reflect"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
+	"unicode"
 	"unicode/utf8"
 
 	"github.com/gookit/goutil/arrutil"
 	"github.com/gookit/goutil/fsutil"
 	"github.com/gookit/goutil/mathutil"
 	"github.com/gookit/goutil/strutil"
+	"golang.org/x/net/html"
+	"golang.org/x/net/publicsuffix"
+	"gopkg.in/yaml.v3"
 )
 
 // Basic regular expressions for validating strings.
@@ -29,6 +48,7 @@ const (
 	Int          = "^(?:[-+]?(?:0|[1-9][0-9]*))$"
 	Float        = "^(?:[-+]?(?:[0-9]+))?(?:\\.[0-9]*)?(?:[eE][\\+\\-]?(?:[0-9]+))?$"
 	RGBColor     = "^rgb\\(\\s*(0|[1-9]\\d?|1\\d\\d?|2[0-4]\\d|25[0-5])\\s*,\\s*(0|[1-9]\\d?|1\\d\\d?|2[0-4]\\d|25[0-5])\\s*,\\s*(0|[1-9]\\d?|1\\d\\d?|2[0-4]\\d|25[0-5])\\s*\\)$"
+	RGBAColor    = "^rgba\\(\\s*(0|[1-9]\\d?|1\\d\\d?|2[0-4]\\d|25[0-5])\\s*,\\s*(0|[1-9]\\d?|1\\d\\d?|2[0-4]\\d|25[0-5])\\s*,\\s*(0|[1-9]\\d?|1\\d\\d?|2[0-4]\\d|25[0-5])\\s*,\\s*(0|1|0?\\.\\d+|1\\.0+|(100|[1-9]?\\d)(\\.\\d+)?%)\\s*\\)$"
 	FullWidth    = "[^\u0020-\u007E\uFF61-\uFF9F\uFFA0-\uFFDC\uFFE8-\uFFEE0-9a-zA-Z]"
 	HalfWidth    = "[\u0020-\u007E\uFF61-\uFF9F\uFFA0-\uFFDC\uFFE8-\uFFEE0-9a-zA-Z]"
 	Base64       = "^(?:[A-Za-z0-9+\\/]{4})*(?:[A-Za-z0-9+\\/]{2}==|[A-Za-z0-9+\\/]{3}=|[A-Za-z0-9+\\/]{4})$"
@@ -64,10 +84,15 @@ var (
 	rxNumber    = regexp.MustCompile("^[0-9]+$")
 	rxInt       = regexp.MustCompile(Int)
 	rxFloat     = regexp.MustCompile(Float)
+	rxDecimal   = regexp.MustCompile(`^[-+]?[0-9]+(\.[0-9]+)?$`)
 	rxCnMobile  = regexp.MustCompile(`^1\d{10}$`)
+	rxE164      = regexp.MustCompile(`^\+[1-9]\d{7,14}$`)
 	rxHexColor  = regexp.MustCompile(`^#?([\da-fA-F]{3}|[\da-fA-F]{6})$`)
 	rxRGBColor  = regexp.MustCompile(RGBColor)
-	rxASCII     = regexp.MustCompile("^[\x00-\x7F]+$")
+	rxRGBAColor = regexp.MustCompile(RGBAColor)
+	// the hex color with "#", allow alpha channel. eg: "#fff", "#ffff", "#ffffff", "#ffffff80"
+	rxCSSHexColor = regexp.MustCompile(`^#([\da-fA-F]{3,4}|[\da-fA-F]{6}|[\da-fA-F]{8})$`)
+	rxASCII       = regexp.MustCompile("^[\x00-\x7F]+$")
 	// --
 	rxHexadecimal    = regexp.MustCompile(`^[\da-fA-F]+$`)
 	rxPrintableASCII = regexp.MustCompile("^[\x20-\x7E]+$")
@@ -104,6 +129,18 @@ type funcMeta struct {
 	builtin bool
 	// last arg is variadic param. like "... any"
 	isVariadic bool
+	// first arg is the validation instance. like "func(v *Validation, val any) bool"
+	withValidation bool
+	// the typed func for the string fast path. like "func(s string) bool". see StringMapData
+	strFunc func(s string) bool
+}
+
+// get the type of the func arg by index, the index 0 is the "val" position.
+func (fm *funcMeta) argType(i int) reflect.Type {
+	if fm.withValidation {
+		i++
+	}
+	return fm.fv.Type().In(i)
 }
 
 func (fm *funcMeta) checkArgNum(argNum int, name string) {
@@ -130,6 +167,13 @@ func newFuncMeta(name string, builtin bool, fv reflect.Value) *funcMeta {
 	fm.numOut = ft.NumOut() // return arg num of the func
 	fm.isVariadic = ft.IsVariadic()
 
+	// first arg is the validation instance, will call with the current validation.
+	if fm.numIn > 1 && ft.In(0) == validationType {
+		fm.withValidation = true
+		fm.numIn--
+	}
+
+	fm.strFunc, _ = fv.Interface().(func(string) bool)
 	return fm
 }
 
@@ -150,6 +194,9 @@ func AddValidators(m map[string]any) {
 
 // AddValidator to the pkg. checkFunc must return a bool
 //
+// The checkFunc can receive the current validation as first arg, use for access other fields.
+// like: func(v *Validation, val any) bool
+//
 // Usage:
 //
 //	v.AddValidator("myFunc", func(val any) bool {
@@ -164,6 +211,30 @@ func AddValidator(name string, checkFunc any) {
 	validatorMetas[name] = newFuncMeta(name, false, fv)
 }
 
+// InSet create a type-safe validator func, check the value is one of the allowed values.
+// the value type must be T, otherwise will return false.
+//
+// Usage:
+//
+//	validate.AddValidator("inLevels", validate.InSet(1, 2, 3))
+//	v.StringRule("level", "inLevels")
+func InSet[T comparable](allowed ...T) func(val any) bool {
+	set := make(map[T]struct{}, len(allowed))
+	for _, item := range allowed {
+		set[item] = struct{}{}
+	}
+
+	return func(val any) bool {
+		tv, ok := val.(T)
+		if !ok {
+			return false
+		}
+
+		_, ok = set[tv]
+		return ok
+	}
+}
+
 // Validators get all validator names
 func Validators() map[string]int8 {
 	return validators
@@ -199,24 +270,30 @@ func (v *Validation) RequiredIf(_ string, val any, kvs ...string) bool {
 		return false
 	}
 
-	dstField, args := kvs[0], kvs[1:]
-	if dstVal, has := v.Get(dstField); has {
-		// up: only one check value, direct compare value
-		if len(args) == 1 {
-			rftDv := reflect.ValueOf(dstVal)
-			wantVal, err := convTypeByBaseKind(args[0], stringKind, rftDv.Kind())
-			if err == nil && dstVal == wantVal {
-				return val != nil && !IsEmpty(val)
-			}
-		} else if Enum(dstVal, args) {
-			return val != nil && !IsEmpty(val)
-		}
+	if v.fieldValueIn(kvs[0], kvs[1:]) {
+		return val != nil && !IsEmpty(val)
 	}
 
 	// default as True, skip check
 	return true
 }
 
+// check the dst field value is in the values
+func (v *Validation) fieldValueIn(dstField string, values []string) bool {
+	dstVal, has := v.Get(dstField)
+	if !has {
+		return false
+	}
+
+	// up: only one check value, direct compare value
+	if len(values) == 1 {
+		rftDv := reflect.ValueOf(dstVal)
+		wantVal, err := convTypeByBaseKind(values[0], stringKind, rftDv.Kind())
+		return err == nil && dstVal == wantVal
+	}
+	return Enum(dstVal, values)
+}
+
 // RequiredUnless field under validation must be present and not empty
 // unless the dstField field is equal to any value.
 //
@@ -306,6 +383,264 @@ func (v *Validation) RequiredWithoutAll(_ string, val any, fields ...string) boo
 	return !IsEmpty(val)
 }
 
+// Forbidden the field must not be submitted, fails on the field key is present, even the value is empty.
+//
+// Usage:
+//
+//	v.StringRule("role", "forbidden")
+func (v *Validation) Forbidden(field string, _ any) bool {
+	if v.data == nil {
+		return true
+	}
+
+	// check on the source data. the StructData field always exists, check it is zero value.
+	_, has, zero := v.data.TryGet(v.sourceKey(field))
+	return !has || zero
+}
+
+// ForbiddenIf the field must not be submitted when the dstField value is in the values.
+//
+// Usage:
+//
+//	v.StringRule("discount", "forbidden_if:type,guest")
+func (v *Validation) ForbiddenIf(field string, val any, kvs ...string) bool {
+	if len(kvs) < 2 {
+		return false
+	}
+
+	if v.fieldValueIn(kvs[0], kvs[1:]) {
+		return v.Forbidden(field, val)
+	}
+	return true
+}
+
+// RequiredAtLeast at least N of the specified fields must be present and not empty.
+// the first arg is N, the remaining args are the field names.
+//
+// Usage:
+//
+//	v.AddRule("contact", "atLeast", "2", "email", "phone", "wechat")
+//	// use string rule
+//	v.StringRule("contact", "at_least:2:email,phone,wechat")
+func (v *Validation) RequiredAtLeast(field string, _ any, args ...string) bool {
+	if len(args) < 2 {
+		return false
+	}
+
+	n, err := strconv.Atoi(args[0])
+	if err != nil {
+		return false
+	}
+
+	// report the required and found number.
+	if num := v.countPresent(args[1:]); num < n {
+		v.setMessageArgs(field, args[0], strings.Join(args[1:], ", "), num)
+		return false
+	}
+	return true
+}
+
+// RequiredTogether the specified fields must be present together.
+// if any of the fields is present, all of them must be present.
+//
+// Usage:
+//
+//	// city requires country, and country requires city
+//	v.StringRule("address", "together:city,country")
+func (v *Validation) RequiredTogether(field string, _ any, fields ...string) bool {
+	missing := v.missingFields(fields)
+	if len(missing) == 0 || len(missing) == len(fields) {
+		return true
+	}
+
+	// report the missing companion fields.
+	v.setMessageArgs(field, strings.Join(fields, ", "), strings.Join(missing, ", "))
+	return false
+}
+
+// get the not present or empty fields
+func (v *Validation) missingFields(fields []string) (missing []string) {
+	for _, name := range fields {
+		if val, has, zero := v.tryGet(name); !has || zero || IsEmpty(val) {
+			missing = append(missing, name)
+		}
+	}
+	return
+}
+
+// RequiredKeys the value must be an object(map) and contains all the keys.
+// only check the key presence, the key value can be any type, even null.
+//
+// Usage:
+//
+//	d, _ := validate.FromRawJSON(body)
+//	v := d.Create()
+//	// "#" is the whole JSON document
+//	v.StringRule("#", "requiredKeys:data,meta")
+//	v.StringRule("/data", "requiredKeys:id,type,attributes")
+func (v *Validation) RequiredKeys(field string, val any, keys ...string) bool {
+	missing, ok := missingKeys(val, keys)
+	if ok && len(missing) == 0 {
+		return true
+	}
+
+	// report the missing keys.
+	v.setMessageArgs(field, strings.Join(missing, ", "))
+	return false
+}
+
+// get the missing keys of the map value. if val is not a map, will return false.
+func missingKeys(val any, keys []string) (missing []string, ok bool) {
+	if mp, isMap := val.(map[string]any); isMap {
+		for _, key := range keys {
+			if _, has := mp[key]; !has {
+				missing = append(missing, key)
+			}
+		}
+		return missing, true
+	}
+
+	rv := reflect.Indirect(reflect.ValueOf(val))
+	if rv.Kind() != reflect.Map || rv.Type().Key().Kind() != reflect.String {
+		return keys, false
+	}
+
+	for _, key := range keys {
+		if !rv.MapIndex(reflect.ValueOf(key).Convert(rv.Type().Key())).IsValid() {
+			missing = append(missing, key)
+		}
+	}
+	return missing, true
+}
+
+// AllowedKeys the value must be an object(map) and has no keys outside the allowed keys.
+//
+// Usage:
+//
+//	v.StringRule("attrs", "allowedKeys:name,age,city")
+func AllowedKeys(val any, keys ...string) bool {
+	extra, ok := extraKeys(val, keys)
+	return ok && len(extra) == 0
+}
+
+// NoEmptyItems check the array/slice value has no empty items. the nil pointer/interface item is empty.
+//
+// Usage:
+//
+//	NoEmptyItems([]string{"a", "b"}) // true
+//	NoEmptyItems([]string{"a", ""}) // false
+func NoEmptyItems(val any) bool {
+	_, ok := firstEmptyItem(val)
+	return ok
+}
+
+// get the index of the first empty item in the array/slice value, return -1 if no empty item.
+// if val is not an array/slice or has empty item, ok will be false
+func firstEmptyItem(val any) (index int, ok bool) {
+	rv := reflect.Indirect(reflect.ValueOf(val))
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return -1, false
+	}
+
+	for i := 0; i < rv.Len(); i++ {
+		if IsEmpty(rv.Index(i).Interface()) {
+			return i, false
+		}
+	}
+	return -1, true
+}
+
+// UniqueFold check the array/slice string items are unique, ignoring the ASCII case.
+// set unicodeFold=true to use the Unicode case folding. eg: "Σ" and "ς" are duplicates
+//
+// Usage:
+//
+//	UniqueFold([]string{"Foo", "bar"}) // true
+//	UniqueFold([]string{"Foo", "foo"}) // false
+//	v.StringRule("names", "uniqueFold:true")
+func UniqueFold(val any, unicodeFold ...bool) bool {
+	_, _, ok := foldDuplicate(val, len(unicodeFold) > 0 && unicodeFold[0])
+	return ok
+}
+
+// get the indexes of the first colliding pair items, ignoring case.
+// if val is not an array/slice of strings or has duplicate items, ok will be false
+func foldDuplicate(val any, unicodeFold bool) (first, second int, ok bool) {
+	rv := reflect.Indirect(reflect.ValueOf(val))
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return -1, -1, false
+	}
+
+	seen := make(map[string]int, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		item := reflect.Indirect(rv.Index(i))
+		if item.Kind() == reflect.Interface {
+			item = reflect.Indirect(item.Elem())
+		}
+		if item.Kind() != reflect.String {
+			return -1, -1, false
+		}
+
+		key := foldKey(item.String(), unicodeFold)
+		if j, has := seen[key]; has {
+			return j, i, false
+		}
+		seen[key] = i
+	}
+	return -1, -1, true
+}
+
+// foldKey returns the case-insensitive key of the string.
+func foldKey(s string, unicodeFold bool) string {
+	if !unicodeFold {
+		return strings.Map(func(r rune) rune {
+			if 'A' <= r && r <= 'Z' {
+				return r + 'a' - 'A'
+			}
+			return r
+		}, s)
+	}
+
+	// map each rune to the smallest rune in its case folding orbit
+	return strings.Map(func(r rune) rune {
+		min := r
+		for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+			if f < min {
+				min = f
+			}
+		}
+		return min
+	}, s)
+}
+
+// get the sorted keys of the map value that are not in the allowed keys. if val is not a map, will return false.
+func extraKeys(val any, keys []string) (extra []string, ok bool) {
+	rv := reflect.Indirect(reflect.ValueOf(val))
+	if rv.Kind() != reflect.Map || rv.Type().Key().Kind() != reflect.String {
+		return nil, false
+	}
+
+	iter := rv.MapRange()
+	for iter.Next() {
+		if key := iter.Key().String(); !arrutil.StringsHas(keys, key) {
+			extra = append(extra, key)
+		}
+	}
+
+	sort.Strings(extra)
+	return extra, true
+}
+
+// count the present and not empty fields
+func (v *Validation) countPresent(fields []string) (num int) {
+	for _, name := range fields {
+		if val, has, zero := v.tryGet(name); has && !zero && !IsEmpty(val) {
+			num++
+		}
+	}
+	return
+}
+
 // EqField value should EQ the dst field value
 func (v *Validation) EqField(val any, dstField string) bool {
 	// get dst field value.
@@ -370,12 +705,209 @@ func (v *Validation) LteField(val any, dstField string) bool {
 	return valueCompare(val, dstVal, "<=")
 }
 
+// LenEqField the value rune length should equal the int value of the dst field
+//
+// Usage:
+//
+//	v.StringRule("code", "lenEqField:codeLen")
+func (v *Validation) LenEqField(val any, dstField string) bool {
+	_, ok := v.lenEqField(val, dstField)
+	return ok
+}
+
+// get the dst field value and check the value rune length is equal to it.
+func (v *Validation) lenEqField(val any, dstField string) (dstVal any, ok bool) {
+	dstVal, has, _ := v.tryGet(dstField)
+	if !has {
+		return nil, false
+	}
+
+	dstLen, err := mathutil.Int(dstVal)
+	return dstVal, err == nil && runeLen(val) == dstLen
+}
+
+// SameLen the value length should equal the length of the dst field. use for the paired arrays.
+//
+// Usage:
+//
+//	v.StringRule("names", "sameLen:ages")
+func (v *Validation) SameLen(val any, dstField string) bool {
+	_, _, ok := v.sameLen(val, dstField)
+	return ok
+}
+
+// get the length of the value and the dst field value, the length is -1 on the value has no length.
+func (v *Validation) sameLen(val any, dstField string) (valLen, dstLen int, ok bool) {
+	valLen, dstLen = reflectLen(val), -1
+	if dstVal, has, _ := v.tryGet(dstField); has {
+		dstLen = reflectLen(dstVal)
+	}
+	return valLen, dstLen, valLen >= 0 && valLen == dstLen
+}
+
+// get the length of the array, slice, map or string value. returns -1 on the value has no length.
+func reflectLen(val any) int {
+	rv := reflect.Indirect(reflect.ValueOf(val))
+	switch rv.Kind() {
+	case reflect.Array, reflect.Slice, reflect.Map, reflect.String:
+		return rv.Len()
+	}
+	return -1
+}
+
+// get the rune length of the value string
+func runeLen(val any) int {
+	return utf8.RuneCountInString(strutil.QuietString(val))
+}
+
+// HMAC check the hex signature in the sigField is equal to the HMAC of the value,
+// the key is the secretField value. allow algo: sha1, sha256, sha512.
+//
+// The signature can have the algo prefix, like the GitHub webhook: "sha256=<hex>".
+// the compare is constant-time.
+//
+// Usage:
+//
+//	v.StringRule("body", "hmac:sha256,secret,signature")
+func (v *Validation) HMAC(val any, algo, secretField, sigField string) bool {
+	var newHash func() hash.Hash
+	switch strings.ToLower(algo) {
+	case "sha1":
+		newHash = sha1.New
+	case "sha256":
+		newHash = sha256.New
+	case "sha512":
+		newHash = sha512.New
+	default:
+		panicf("invalid hmac algo '%s', allow: sha1, sha256, sha512", algo)
+	}
+
+	secret, has, _ := v.tryGet(secretField)
+	if !has {
+		return false
+	}
+	sigVal, has, _ := v.tryGet(sigField)
+	if !has {
+		return false
+	}
+
+	sig := strings.TrimPrefix(strutil.QuietString(sigVal), strings.ToLower(algo)+"=")
+	want, err := hex.DecodeString(sig)
+	if err != nil {
+		return false
+	}
+
+	var body []byte
+	if bs, ok := val.([]byte); ok {
+		body = bs
+	} else {
+		body = []byte(strutil.QuietString(val))
+	}
+
+	mac := hmac.New(newHash, []byte(strutil.QuietString(secret)))
+	mac.Write(body)
+	return hmac.Equal(mac.Sum(nil), want)
+}
+
+// MinAge check the age calculated from the date value is greater than or equal to the min age.
+// the current time is get by the clock, see WithClock
+//
+// Usage:
+//
+//	v.StringRule("dob", "min_age:18")
+func (v *Validation) MinAge(val any, minAge int) bool {
+	age, ok := v.calcAge(val)
+	return ok && age >= minAge
+}
+
+// MaxAge check the age calculated from the date value is less than or equal to the max age.
+func (v *Validation) MaxAge(val any, maxAge int) bool {
+	age, ok := v.calcAge(val)
+	return ok && age <= maxAge
+}
+
+// WithinDays check the span days between the value and the dst field date is not exceeds the max days.
+//
+// Usage:
+//
+//	v.StringRule("end_date", "within_days:30:start_date")
+func (v *Validation) WithinDays(val any, maxDays int, dstField string) bool {
+	span, ok := v.daysSpan(val, dstField)
+	return ok && span <= time.Duration(maxDays)*24*time.Hour
+}
+
+// MinDays check the span days between the value and the dst field date is at least the min days.
+//
+// Usage:
+//
+//	v.StringRule("end_date", "min_days:1:start_date")
+func (v *Validation) MinDays(val any, minDays int, dstField string) bool {
+	span, ok := v.daysSpan(val, dstField)
+	return ok && span >= time.Duration(minDays)*24*time.Hour
+}
+
+// get the absolute time span between the value and the dst field date
+func (v *Validation) daysSpan(val any, dstField string) (time.Duration, bool) {
+	st, ok := toTime(val)
+	if !ok {
+		return 0, false
+	}
+
+	dstVal, has, _ := v.tryGet(dstField)
+	if !has {
+		return 0, false
+	}
+
+	dt, ok := toTime(dstVal)
+	if !ok {
+		return 0, false
+	}
+
+	if span := st.Sub(dt); span >= 0 {
+		return span, true
+	}
+	return dt.Sub(st), true
+}
+
+// convert the date value to time. allow: time.Time, *time.Time, date string
+func toTime(val any) (time.Time, bool) {
+	switch typVal := val.(type) {
+	case time.Time:
+		return typVal, true
+	case *time.Time:
+		if typVal != nil {
+			return *typVal, true
+		}
+	case string:
+		if t, err := strutil.ToTime(typVal); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// calc the age by the date value. allow: time.Time, *time.Time, date string
+func (v *Validation) calcAge(val any) (int, bool) {
+	dob, ok := toTime(val)
+	if !ok {
+		return 0, false
+	}
+
+	now := v.now()
+	age := now.Year() - dob.Year()
+	// the birthday of this year has not arrived
+	if now.Month() < dob.Month() || (now.Month() == dob.Month() && now.Day() < dob.Day()) {
+		age--
+	}
+	return age, true
+}
+
 /*************************************************************
  * context validators:
  *  - file validators
  *************************************************************/
 
-const fileValidators = "|isFile|isImage|inMimeTypes|"
+const fileValidators = "|isFile|isImage|inMimeTypes|imageDimensions|"
 
 var (
 	imageMimeTypes = map[string]string{
@@ -449,21 +981,119 @@ func (v *Validation) InMimeTypes(fd *FormData, field, mimeType string, moreTypes
 	return Enum(mime, mimeTypes)
 }
 
-/*************************************************************
- * global: basic validators
- *************************************************************/
-
-// IsEmpty of the value
-func IsEmpty(val any) bool {
-	if val == nil {
-		return true
+// ImageDimensions check field is uploaded image file and the image width, height
+// matches the given limits. only decode the image header, not decode the entire image.
+//
+// limit format: "key=value", key allow:
+//   - minW, maxW, minH, maxH: the min/max width, height
+//   - w, h: the exact width, height
+//
+// Usage:
+//
+//	v.AddRule("avatar", "dimensions", "maxW=512", "maxH=512")
+//	// use string rule
+//	v.StringRule("avatar", "dimensions:minW=64,maxW=512,h=256")
+func (v *Validation) ImageDimensions(fd *FormData, field string, limits ...string) bool {
+	cfg, err := fd.FileImageConfig(field)
+	if err != nil {
+		return false
 	}
 
-	if s, ok := val.(string); ok {
-		return s == ""
-	}
-	return ValueIsEmpty(reflect.ValueOf(val))
-}
+	for _, limit := range limits {
+		key, val, ok := strings.Cut(limit, "=")
+		if !ok {
+			panicf("invalid image dimensions limit '%s', format must be 'key=value'", limit)
+		}
+
+		num, err := strconv.Atoi(strings.TrimSpace(val))
+		if err != nil {
+			panicf("invalid image dimensions limit '%s', value must be an integer", limit)
+		}
+
+		switch strings.TrimSpace(key) {
+		case "minW":
+			ok = cfg.Width >= num
+		case "maxW":
+			ok = cfg.Width <= num
+		case "minH":
+			ok = cfg.Height >= num
+		case "maxH":
+			ok = cfg.Height <= num
+		case "w":
+			ok = cfg.Width == num
+		case "h":
+			ok = cfg.Height == num
+		default:
+			panicf("invalid image dimensions limit key '%s'", key)
+		}
+
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+/*************************************************************
+ * global: basic validators
+ *************************************************************/
+
+// custom empty check func by value type. see RegisterEmptyFunc()
+var (
+	emptyFuncsMu sync.RWMutex
+	emptyFuncs   = make(map[reflect.Type]func(val any) bool)
+)
+
+// RegisterEmptyFunc register custom empty check func for the given type.
+// typ allow: reflect.Type or a value of the type.
+//
+// Usage:
+//
+//	type Money int64
+//	// -1 means "unset", 0 is a valid amount
+//	validate.RegisterEmptyFunc(Money(0), func(val any) bool {
+//		return val.(Money) == -1
+//	})
+func RegisterEmptyFunc(typ any, fn func(val any) bool) {
+	rt, ok := typ.(reflect.Type)
+	if !ok {
+		rt = reflect.TypeOf(typ)
+	}
+
+	if rt == nil || fn == nil {
+		panicf("RegisterEmptyFunc: the type and check func cannot be nil")
+	}
+	emptyFuncsMu.Lock()
+	emptyFuncs[rt] = fn
+	emptyFuncsMu.Unlock()
+}
+
+// get the custom empty check func by the value type
+func getEmptyFunc(val any) (fn func(val any) bool, ok bool) {
+	emptyFuncsMu.RLock()
+	if len(emptyFuncs) > 0 {
+		fn, ok = emptyFuncs[reflect.TypeOf(val)]
+	}
+	emptyFuncsMu.RUnlock()
+	return
+}
+
+// IsEmpty of the value
+func IsEmpty(val any) bool {
+	if val == nil {
+		return true
+	}
+
+	// check by custom empty func
+	if fn, ok := getEmptyFunc(val); ok {
+		return fn(val)
+	}
+
+	if s, ok := val.(string); ok {
+		return s == ""
+	}
+	return ValueIsEmpty(reflect.ValueOf(val))
+}
 
 // Contains check that the specified string, list(array, slice) or map contains the
 // specified substring or element.
@@ -489,6 +1119,35 @@ func NotContains(s, sub any) bool {
 	return ok && !found
 }
 
+// ContainsValue check the list(array, slice) contains all the required values.
+// the elements will be compared as string.
+//
+// Usage:
+//
+//	v.StringRule("tags", "contains_value:admin,owner")
+func ContainsValue(list any, values ...string) bool {
+	if list == nil || len(values) == 0 {
+		return false
+	}
+
+	rv := reflect.Indirect(reflect.ValueOf(list))
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return false
+	}
+
+	elems := make(map[string]struct{}, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		elems[strutil.QuietString(rv.Index(i).Interface())] = struct{}{}
+	}
+
+	for _, val := range values {
+		if _, ok := elems[val]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
 /*************************************************************
  * global: type validators
  *************************************************************/
@@ -515,6 +1174,204 @@ func IsUint(val any) bool {
 	return false
 }
 
+// the target types for the IsNumOf() check
+var numOfTypes = map[string]reflect.Type{
+	"int":     reflect.TypeOf(int(0)),
+	"int8":    reflect.TypeOf(int8(0)),
+	"int16":   reflect.TypeOf(int16(0)),
+	"int32":   reflect.TypeOf(int32(0)),
+	"int64":   reflect.TypeOf(int64(0)),
+	"uint":    reflect.TypeOf(uint(0)),
+	"uint8":   reflect.TypeOf(uint8(0)),
+	"uint16":  reflect.TypeOf(uint16(0)),
+	"uint32":  reflect.TypeOf(uint32(0)),
+	"uint64":  reflect.TypeOf(uint64(0)),
+	"float32": reflect.TypeOf(float32(0)),
+	"float64": reflect.TypeOf(float64(0)),
+}
+
+// IsNumOf check the value can be parsed or converted to the exact numeric type without overflow.
+// allow typ: int, int8 ... int64, uint, uint8 ... uint64, float32, float64
+//
+// Usage:
+//
+//	IsNumOf("32767", "int16") // true
+//	IsNumOf("99999999999", "int16") // false
+//	IsNumOf(300, "uint8") // false
+func IsNumOf(val any, typ string) bool {
+	dst, ok := numOfTypes[typ]
+	if !ok {
+		panicf("invalid numeric type '%s' for check", typ)
+	}
+
+	val = indirectValue(val)
+	if val == nil {
+		return false
+	}
+
+	dstKind := dst.Kind()
+	if s, isStr := val.(string); isStr {
+		var err error
+		switch {
+		case dstKind >= reflect.Int && dstKind <= reflect.Int64:
+			_, err = strconv.ParseInt(s, 10, dst.Bits())
+		case dstKind >= reflect.Uint && dstKind <= reflect.Uint64:
+			_, err = strconv.ParseUint(s, 10, dst.Bits())
+		default:
+			_, err = strconv.ParseFloat(s, dst.Bits())
+		}
+		return err == nil
+	}
+
+	dv := reflect.Zero(dst)
+	rv := reflect.ValueOf(val)
+	switch kind := rv.Kind(); {
+	case kind >= reflect.Int && kind <= reflect.Int64:
+		num := rv.Int()
+		switch {
+		case dstKind >= reflect.Int && dstKind <= reflect.Int64:
+			return !dv.OverflowInt(num)
+		case dstKind >= reflect.Uint && dstKind <= reflect.Uint64:
+			return num >= 0 && !dv.OverflowUint(uint64(num))
+		}
+		return !dv.OverflowFloat(float64(num))
+	case kind >= reflect.Uint && kind <= reflect.Uintptr:
+		num := rv.Uint()
+		switch {
+		case dstKind >= reflect.Int && dstKind <= reflect.Int64:
+			return num <= math.MaxInt64 && !dv.OverflowInt(int64(num))
+		case dstKind >= reflect.Uint && dstKind <= reflect.Uint64:
+			return !dv.OverflowUint(num)
+		}
+		return !dv.OverflowFloat(float64(num))
+	case kind == reflect.Float32 || kind == reflect.Float64:
+		num := rv.Float()
+		switch {
+		case dstKind >= reflect.Int && dstKind <= reflect.Int64:
+			// must be a whole number in the range [-2^(bits-1), 2^(bits-1))
+			bound := math.Ldexp(1, dst.Bits()-1)
+			return num == math.Trunc(num) && num >= -bound && num < bound
+		case dstKind >= reflect.Uint && dstKind <= reflect.Uint64:
+			return num == math.Trunc(num) && num >= 0 && num < math.Ldexp(1, dst.Bits())
+		}
+		return !dv.OverflowFloat(num)
+	}
+	return false
+}
+
+// convert the value to the numeric type, the value should be checked by IsNumOf()
+func convNumOf(val any, dst reflect.Type) (any, bool) {
+	val = indirectValue(val)
+	if val == nil || dst == nil {
+		return nil, false
+	}
+
+	rv := reflect.ValueOf(val)
+	if s, isStr := val.(string); isStr {
+		var err error
+		switch kind := dst.Kind(); {
+		case kind >= reflect.Int && kind <= reflect.Int64:
+			var num int64
+			num, err = strconv.ParseInt(s, 10, dst.Bits())
+			rv = reflect.ValueOf(num)
+		case kind >= reflect.Uint && kind <= reflect.Uint64:
+			var num uint64
+			num, err = strconv.ParseUint(s, 10, dst.Bits())
+			rv = reflect.ValueOf(num)
+		default:
+			var num float64
+			num, err = strconv.ParseFloat(s, dst.Bits())
+			rv = reflect.ValueOf(num)
+		}
+		if err != nil {
+			return nil, false
+		}
+	}
+
+	if !rv.CanConvert(dst) {
+		return nil, false
+	}
+	return rv.Convert(dst).Interface(), true
+}
+
+// IsInt8 check the value is in the int8 range. allow: intX, uintX, floatX, string
+func IsInt8(val any) bool { return IsNumOf(val, "int8") }
+
+// IsInt16 check the value is in the int16 range. allow: intX, uintX, floatX, string
+func IsInt16(val any) bool { return IsNumOf(val, "int16") }
+
+// IsInt32 check the value is in the int32 range. allow: intX, uintX, floatX, string
+func IsInt32(val any) bool { return IsNumOf(val, "int32") }
+
+// IsInt64 check the value is in the int64 range. allow: intX, uintX, floatX, string
+func IsInt64(val any) bool { return IsNumOf(val, "int64") }
+
+// IsUint8 check the value is in the uint8 range. allow: intX, uintX, floatX, string
+func IsUint8(val any) bool { return IsNumOf(val, "uint8") }
+
+// IsUint16 check the value is in the uint16 range. allow: intX, uintX, floatX, string
+func IsUint16(val any) bool { return IsNumOf(val, "uint16") }
+
+// IsUint32 check the value is in the uint32 range. allow: intX, uintX, floatX, string
+func IsUint32(val any) bool { return IsNumOf(val, "uint32") }
+
+// IsUint64 check the value is in the uint64 range. allow: intX, uintX, floatX, string
+func IsUint64(val any) bool { return IsNumOf(val, "uint64") }
+
+// IsFloat32 check the value is in the float32 range. allow: intX, uintX, floatX, string
+func IsFloat32(val any) bool { return IsNumOf(val, "float32") }
+
+// IsFloat64 check the value is in the float64 range. allow: intX, uintX, floatX, string
+func IsFloat64(val any) bool { return IsNumOf(val, "float64") }
+
+// IsInteger check the number value has no fractional part. allow: intX, uintX, floatX, json.Number, string.
+// useful for the JSON decode with UseNumber, the float value like 5.0 is always passed.
+//
+// For the json.Number and string value like "5.0", it fails by default,
+// set allowZeroFrac=true to allow the zero fractional part.
+//
+// Usage:
+//
+//	IsInteger(json.Number("5")) // true
+//	IsInteger(json.Number("5.0")) // false
+//	IsInteger(json.Number("5.0"), true) // true
+//	IsInteger(5.5) // false
+func IsInteger(val any, allowZeroFrac ...bool) bool {
+	val = indirectValue(val)
+
+	var s string
+	switch rv := val.(type) {
+	case nil:
+		return false
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return true
+	case float32:
+		return isWholeFloat(float64(rv))
+	case float64:
+		return isWholeFloat(rv)
+	case json.Number:
+		s = rv.String()
+	case string:
+		s = strings.TrimSpace(rv)
+	default:
+		return false
+	}
+
+	if _, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return true
+	}
+
+	if len(allowZeroFrac) > 0 && allowZeroFrac[0] {
+		f, err := strconv.ParseFloat(s, 64)
+		return err == nil && isWholeFloat(f)
+	}
+	return false
+}
+
+func isWholeFloat(f float64) bool {
+	return !math.IsInf(f, 0) && f == math.Trunc(f)
+}
+
 // IsBool check. allow: bool, string.
 func IsBool(val any) bool {
 	val = indirectValue(val)
@@ -547,6 +1404,48 @@ func IsFloat(val any) bool {
 	return false
 }
 
+// Decimals check the number value has at most N decimal places, checked on the string representation
+// to avoid float imprecision. set mode "exact" to require exactly N decimal places.
+//
+// Usage:
+//
+//	Decimals("1.99", 2) // true
+//	Decimals("1.999", 2) // false
+//	Decimals("10", 2, "exact") // false
+func Decimals(val any, places int, mode ...string) bool {
+	val = indirectValue(val)
+
+	var s string
+	switch rv := val.(type) {
+	case nil:
+		return false
+	case float32:
+		s = strconv.FormatFloat(float64(rv), 'f', -1, 32)
+	case float64:
+		s = strconv.FormatFloat(rv, 'f', -1, 64)
+	default:
+		var err error
+		if s, err = strutil.ToString(val); err != nil {
+			return false
+		}
+	}
+
+	s = strings.TrimSpace(s)
+	if !rxDecimal.MatchString(s) {
+		return false
+	}
+
+	var num int
+	if pos := strings.IndexByte(s, '.'); pos >= 0 {
+		num = len(s) - pos - 1
+	}
+
+	if len(mode) > 0 && mode[0] == "exact" {
+		return num == places
+	}
+	return num <= places
+}
+
 // IsArray check value is array or slice.
 func IsArray(val any, strict ...bool) (ok bool) {
 	if val == nil {
@@ -683,6 +1582,17 @@ func HasWhitespace(s string) bool {
 	return s != "" && strings.ContainsRune(s, ' ')
 }
 
+// NoSurroundingSpace check the string has no leading or trailing whitespace. eg: " inhere" is invalid
+func NoSurroundingSpace(s string) bool {
+	return s == strings.TrimSpace(s)
+}
+
+// NoLeadingZero check the numeric string has no leading zeros. eg: "7", "0" is valid, "007" is invalid
+func NoLeadingZero(s string) bool {
+	s = strings.TrimLeft(s, "+-")
+	return s == "0" || !strings.HasPrefix(s, "0")
+}
+
 // IsIntString check. eg "10"
 func IsIntString(s string) bool {
 	return s != "" && rxInt.MatchString(s)
@@ -698,6 +1608,33 @@ func IsPrintableASCII(s string) bool {
 	return s != "" && rxPrintableASCII.MatchString(s)
 }
 
+// IsPrintable check the string not contains non-printable control chars.
+// can allow newline and tab by the allows: "newline", "tab"
+//
+// Usage:
+//
+//	IsPrintable("hello") // true
+//	IsPrintable("hello\nworld", "newline") // true
+func IsPrintable(s string, allows ...string) bool {
+	var allowNL, allowTab bool
+	for _, allow := range allows {
+		switch allow {
+		case "newline", "\n":
+			allowNL = true
+		case "tab", "\t":
+			allowTab = true
+		}
+	}
+
+	for _, r := range s {
+		if unicode.IsPrint(r) || (allowNL && (r == '\n' || r == '\r')) || (allowTab && r == '\t') {
+			continue
+		}
+		return false
+	}
+	return s != ""
+}
+
 // IsBase64 string.
 func IsBase64(s string) bool {
 	return s != "" && rxBase64.MatchString(s)
@@ -718,6 +1655,33 @@ func IsDNSName(s string) bool {
 	return s != "" && rxDNSName.MatchString(s)
 }
 
+// HasPublicSuffix check the domain has a valid public suffix, by the public suffix list.
+// if etldPlusOne is true, check the domain is an eTLD+1. eg: "example.co.uk"
+//
+// Usage:
+//
+//	HasPublicSuffix("www.example.com") // true
+//	HasPublicSuffix("example.co.uk", true) // true
+//	HasPublicSuffix("www.example.co.uk", true) // false
+func HasPublicSuffix(s string, etldPlusOne ...bool) bool {
+	s = strings.TrimSuffix(strings.ToLower(s), ".")
+	if !IsDNSName(s) || !strings.Contains(s, ".") {
+		return false
+	}
+
+	// the unlisted TLD will be matched by the default rule "*", it is not ICANN.
+	suffix, icann := publicsuffix.PublicSuffix(s)
+	if !icann && !strings.Contains(suffix, ".") {
+		return false
+	}
+
+	if len(etldPlusOne) > 0 && etldPlusOne[0] {
+		domain, err := publicsuffix.EffectiveTLDPlusOne(s)
+		return err == nil && domain == s
+	}
+	return suffix != s
+}
+
 // HasURLSchema string.
 func HasURLSchema(s string) bool {
 	return s != "" && rxURLSchema.MatchString(s)
@@ -746,6 +1710,31 @@ func IsDataURI(s string) bool {
 	return s != "" && rxDataURI.MatchString(s)
 }
 
+// IsMediaType check the string is a valid MIME media type, parameters are allowed.
+// can limit the media type by the prefix. eg: "image/"
+//
+// Usage:
+//
+//	IsMediaType("text/html; charset=utf-8") // true
+//	IsMediaType("image/png", "image/") // true
+func IsMediaType(s string, prefix ...string) bool {
+	mediaType, _, err := mime.ParseMediaType(s)
+	if err != nil {
+		return false
+	}
+
+	// must be "type/subtype"
+	pos := strings.IndexByte(mediaType, '/')
+	if pos <= 0 || pos == len(mediaType)-1 {
+		return false
+	}
+
+	if len(prefix) > 0 && prefix[0] != "" {
+		return strings.HasPrefix(mediaType, strings.ToLower(prefix[0]))
+	}
+	return true
+}
+
 // IsMultiByte string.
 func IsMultiByte(s string) bool {
 	return s != "" && rxMultiByte.MatchString(s)
@@ -771,6 +1760,13 @@ func IsCnMobile(s string) bool {
 	return s != "" && rxCnMobile.MatchString(s)
 }
 
+// IsE164 check the string is an E.164 phone number, "+" followed by 8-15 digits. eg: "+14155552671"
+//
+// NOTE: it is a loosely format check, does not verify the country code.
+func IsE164(s string) bool {
+	return s != "" && rxE164.MatchString(s)
+}
+
 // IsHexColor string.
 func IsHexColor(s string) bool {
 	return s != "" && rxHexColor.MatchString(s)
@@ -781,6 +1777,76 @@ func IsRGBColor(s string) bool {
 	return s != "" && rxRGBColor.MatchString(s)
 }
 
+// the CSS named colors, contains the keyword "transparent".
+var cssNamedColors = map[string]bool{
+	"aliceblue": true, "antiquewhite": true, "aqua": true, "aquamarine": true, "azure": true, "beige": true,
+	"bisque": true, "black": true, "blanchedalmond": true, "blue": true, "blueviolet": true, "brown": true,
+	"burlywood": true, "cadetblue": true, "chartreuse": true, "chocolate": true, "coral": true,
+	"cornflowerblue": true, "cornsilk": true, "crimson": true, "cyan": true, "darkblue": true, "darkcyan": true,
+	"darkgoldenrod": true, "darkgray": true, "darkgreen": true, "darkgrey": true, "darkkhaki": true,
+	"darkmagenta": true, "darkolivegreen": true, "darkorange": true, "darkorchid": true, "darkred": true,
+	"darksalmon": true, "darkseagreen": true, "darkslateblue": true, "darkslategray": true,
+	"darkslategrey": true, "darkturquoise": true, "darkviolet": true, "deeppink": true, "deepskyblue": true,
+	"dimgray": true, "dimgrey": true, "dodgerblue": true, "firebrick": true, "floralwhite": true,
+	"forestgreen": true, "fuchsia": true, "gainsboro": true, "ghostwhite": true, "gold": true,
+	"goldenrod": true, "gray": true, "green": true, "greenyellow": true, "grey": true, "honeydew": true,
+	"hotpink": true, "indianred": true, "indigo": true, "ivory": true, "khaki": true, "lavender": true,
+	"lavenderblush": true, "lawngreen": true, "lemonchiffon": true, "lightblue": true, "lightcoral": true,
+	"lightcyan": true, "lightgoldenrodyellow": true, "lightgray": true, "lightgreen": true, "lightgrey": true,
+	"lightpink": true, "lightsalmon": true, "lightseagreen": true, "lightskyblue": true, "lightslategray": true,
+	"lightslategrey": true, "lightsteelblue": true, "lightyellow": true, "lime": true, "limegreen": true,
+	"linen": true, "magenta": true, "maroon": true, "mediumaquamarine": true, "mediumblue": true,
+	"mediumorchid": true, "mediumpurple": true, "mediumseagreen": true, "mediumslateblue": true,
+	"mediumspringgreen": true, "mediumturquoise": true, "mediumvioletred": true, "midnightblue": true,
+	"mintcream": true, "mistyrose": true, "moccasin": true, "navajowhite": true, "navy": true, "oldlace": true,
+	"olive": true, "olivedrab": true, "orange": true, "orangered": true, "orchid": true, "palegoldenrod": true,
+	"palegreen": true, "paleturquoise": true, "palevioletred": true, "papayawhip": true, "peachpuff": true,
+	"peru": true, "pink": true, "plum": true, "powderblue": true, "purple": true, "rebeccapurple": true,
+	"red": true, "rosybrown": true, "royalblue": true, "saddlebrown": true, "salmon": true, "sandybrown": true,
+	"seagreen": true, "seashell": true, "sienna": true, "silver": true, "skyblue": true, "slateblue": true,
+	"slategray": true, "slategrey": true, "snow": true, "springgreen": true, "steelblue": true, "tan": true,
+	"teal": true, "thistle": true, "tomato": true, "transparent": true, "turquoise": true, "violet": true,
+	"wheat": true, "white": true, "whitesmoke": true, "yellow": true, "yellowgreen": true,
+}
+
+// IsColor check the string is a CSS color value. allow formats: "hex", "rgb", "rgba", "named".
+// default allow all formats.
+//
+// Usage:
+//
+//	IsColor("#fff") // true
+//	IsColor("rgba(0, 0, 0, 0.5)") // true
+//	IsColor("red", "hex", "rgb") // false
+func IsColor(s string, formats ...string) bool {
+	if s == "" {
+		return false
+	}
+	if len(formats) == 0 {
+		formats = []string{"hex", "rgb", "rgba", "named"}
+	}
+
+	for _, format := range formats {
+		var ok bool
+		switch strings.TrimSpace(format) {
+		case "hex":
+			ok = rxCSSHexColor.MatchString(s)
+		case "rgb":
+			ok = rxRGBColor.MatchString(s)
+		case "rgba":
+			ok = rxRGBAColor.MatchString(s)
+		case "named":
+			ok = cssNamedColors[strings.ToLower(s)]
+		default:
+			panicf("invalid color format '%s', allow: hex, rgb, rgba, named", format)
+		}
+
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
 // IsAlpha string.
 func IsAlpha(s string) bool {
 	return s != "" && rxAlpha.MatchString(s)
@@ -924,51 +1990,301 @@ func IsJSON(s string) bool {
 	return err == nil
 }
 
-// HasLowerCase check string has lower case
-func HasLowerCase(s string) bool {
+// IsYAML check if the string is valid YAML. set kind="map" to require the top level is a mapping.
+//
+// Usage:
+//
+//	IsYAML("name: inhere") // true
+//	IsYAML("- a\n- b") // true
+//	IsYAML("- a\n- b", "map") // false
+func IsYAML(s string, kind ...string) bool {
 	if s == "" {
 		return false
 	}
-	return rxHasLowerCase.MatchString(s)
-}
 
-// HasUpperCase check string has upper case
-func HasUpperCase(s string) bool {
-	return s != "" && rxHasUpperCase.MatchString(s)
-}
+	var val any
+	if err := yaml.Unmarshal([]byte(s), &val); err != nil {
+		return false
+	}
 
-// StartsWith check string is starts with sub-string
-func StartsWith(s, sub string) bool {
-	return s != "" && strings.HasPrefix(s, sub)
-}
+	if len(kind) == 0 {
+		return true
+	}
+	if kind[0] != "map" {
+		panicf("invalid yaml kind '%s', allow: map", kind[0])
+	}
 
-// EndsWith check string is ends with sub-string
-func EndsWith(s, sub string) bool {
-	return s != "" && strings.HasSuffix(s, sub)
+	// the map key maybe not a string. eg: "1: a"
+	return reflect.ValueOf(val).Kind() == reflect.Map
 }
 
-// StringContains check string is contains sub-string
-func StringContains(s, sub string) bool {
-	return s != "" && strings.Contains(s, sub)
-}
+// IsJSONPointer check the string is a valid RFC 6901 JSON pointer. eg: "/data/items/0", "/a~1b"
+func IsJSONPointer(s string) bool {
+	if s == "" {
+		return true
+	}
+	if s[0] != '/' {
+		return false
+	}
 
-// Regexp match value string
-func Regexp(str string, pattern string) bool {
-	ok, _ := regexp.MatchString(pattern, str)
-	return ok
+	// "~" must be escaped as "~0" or "~1"
+	for i := 0; i < len(s); i++ {
+		if s[i] == '~' && (i+1 >= len(s) || (s[i+1] != '0' && s[i+1] != '1')) {
+			return false
+		}
+	}
+	return true
 }
 
-/*************************************************************
- * global: filesystem validators
- *************************************************************/
+// IsJSONPath check the string is a valid JSON path syntax. eg: "$.store.book[0].title", "$..price", "$.items[?(@.id > 2)]"
+//
+// NOTE: only check the syntax of the path segments, the filter expression will not be parsed.
+func IsJSONPath(s string) bool {
+	if s == "" || s[0] != '$' {
+		return false
+	}
 
-// PathExists reports whether the named file or directory exists.
-func PathExists(path string) bool {
-	return fsutil.PathExists(path)
-}
+	for i := 1; i < len(s); {
+		switch s[i] {
+		case '.':
+			i++
+			// recursive descent. eg: "$..price" "$..[0]"
+			if i < len(s) && s[i] == '.' {
+				i++
+				if i < len(s) && s[i] == '[' {
+					continue
+				}
+			}
 
-// IsFilePath path is a local filepath
-func IsFilePath(path string) bool {
+			if i < len(s) && s[i] == '*' {
+				i++
+				continue
+			}
+
+			start := i
+			for i < len(s) && isPathNameChar(s[i]) {
+				i++
+			}
+			if i == start {
+				return false
+			}
+		case '[':
+			end := pathBracketEnd(s, i+1)
+			if end < 0 || !isPathBracket(strings.TrimSpace(s[i+1:end])) {
+				return false
+			}
+			i = end + 1
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// htmlVoidElements the elements that have no closing tag.
+var htmlVoidElements = map[string]bool{
+	"area": true, "base": true, "br": true, "col": true, "embed": true, "hr": true, "img": true,
+	"input": true, "link": true, "meta": true, "param": true, "source": true, "track": true, "wbr": true,
+}
+
+// IsHTMLValid check the string is well-formed HTML: every opened tag must be closed in order.
+// eg: "<p>hello <b>world</b></p>", "line<br>"
+//
+// NOTE: unlike the "escapeHtml" filter, the markup is kept and only its structure is checked.
+func IsHTMLValid(s string) bool {
+	var stack []string
+	z := html.NewTokenizer(strings.NewReader(s))
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			// io.EOF means the whole input has been consumed
+			return z.Err() == io.EOF && len(stack) == 0
+		case html.StartTagToken:
+			name, _ := z.TagName()
+			if tag := string(name); !htmlVoidElements[tag] {
+				stack = append(stack, tag)
+			}
+		case html.EndTagToken:
+			name, _ := z.TagName()
+			tag := string(name)
+			if htmlVoidElements[tag] {
+				continue
+			}
+			if len(stack) == 0 || stack[len(stack)-1] != tag {
+				return false
+			}
+			stack = stack[:len(stack)-1]
+		}
+	}
+}
+
+func isPathNameChar(c byte) bool {
+	return c == '_' || c == '-' || c == '$' || c >= 0x80 ||
+		(c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+// find the end "]" index of the bracket, skip the quoted string and parentheses.
+func pathBracketEnd(s string, start int) int {
+	var quote byte
+	var depth int
+	for i := start; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+		case c == ']' && depth == 0:
+			return i
+		}
+	}
+	return -1
+}
+
+// check the bracket content. eg: "*", "0", "0,1", "1:3", "'name'", "?(@.id > 2)"
+func isPathBracket(c string) bool {
+	if c == "" {
+		return false
+	}
+	if c == "*" {
+		return true
+	}
+
+	// filter or script expression
+	if c[0] == '?' || c[0] == '(' {
+		c = strings.TrimSpace(strings.TrimPrefix(c, "?"))
+		return len(c) > 2 && c[0] == '(' && c[len(c)-1] == ')'
+	}
+
+	// array slice. eg: "1:3", ":2", "::2"
+	if strings.ContainsRune(c, ':') {
+		parts := strings.Split(c, ":")
+		if len(parts) > 3 {
+			return false
+		}
+		for _, part := range parts {
+			if part = strings.TrimSpace(part); part != "" && !IsIntString(part) {
+				return false
+			}
+		}
+		return true
+	}
+
+	// union of the quoted names or indexes. eg: "'a','b'", "0,1"
+	for _, item := range strings.Split(c, ",") {
+		item = strings.TrimSpace(item)
+		if len(item) >= 2 && (item[0] == '\'' || item[0] == '"') && item[len(item)-1] == item[0] {
+			continue
+		}
+		if !IsIntString(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsJWT check the string is a JSON Web Token structure. eg: "xxx.yyy.zzz"
+//
+// will check the header and payload are base64url-encoded JSON object,
+// NOTE: it does not verify the signature.
+func IsJWT(s string) bool {
+	parts := strings.Split(s, ".")
+	if len(parts) != 3 {
+		return false
+	}
+
+	for i, part := range parts {
+		bs, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(part, "="))
+		if err != nil {
+			return false
+		}
+
+		// header and payload must be JSON object
+		if i < 2 {
+			var mp map[string]any
+			if len(bs) == 0 || json.Unmarshal(bs, &mp) != nil {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// HasLowerCase check string has lower case
+func HasLowerCase(s string) bool {
+	if s == "" {
+		return false
+	}
+	return rxHasLowerCase.MatchString(s)
+}
+
+// HasUpperCase check string has upper case
+func HasUpperCase(s string) bool {
+	return s != "" && rxHasUpperCase.MatchString(s)
+}
+
+// StartsWith check string is starts with sub-string
+func StartsWith(s, sub string) bool {
+	return s != "" && strings.HasPrefix(s, sub)
+}
+
+// EndsWith check string is ends with sub-string
+func EndsWith(s, sub string) bool {
+	return s != "" && strings.HasSuffix(s, sub)
+}
+
+// StringContains check string is contains sub-string
+func StringContains(s, sub string) bool {
+	return s != "" && strings.Contains(s, sub)
+}
+
+// Regexp match value string
+func Regexp(str string, pattern string) bool {
+	ok, _ := regexp.MatchString(pattern, str)
+	return ok
+}
+
+// IsRegex check the string is a valid regular expression pattern(RE2 syntax).
+func IsRegex(s string) bool {
+	_, err := regexp.Compile(s)
+	return err == nil
+}
+
+// Glob match value string by the glob pattern, use path.Match semantics.
+// flags allow: "i" - case-insensitive match
+//
+// Usage:
+//
+//	Glob("notes.txt", "*.txt") // true
+//	Glob("NOTES.TXT", "*.txt", "i") // true
+func Glob(str string, pattern string, flags ...string) bool {
+	if len(flags) > 0 && flags[0] == "i" {
+		str, pattern = strings.ToLower(str), strings.ToLower(pattern)
+	}
+
+	ok, _ := path.Match(pattern, str)
+	return ok
+}
+
+/*************************************************************
+ * global: filesystem validators
+ *************************************************************/
+
+// PathExists reports whether the named file or directory exists.
+func PathExists(path string) bool {
+	return fsutil.PathExists(path)
+}
+
+// IsFilePath path is a local filepath
+func IsFilePath(path string) bool {
 	return fsutil.IsFile(path)
 }
 
@@ -987,6 +2303,45 @@ func IsUnixPath(s string) bool {
 	return s != "" && rxUnixPath.MatchString(s)
 }
 
+// IsValidPath check the string is syntactically valid path, the path does not need to exist.
+// allow modes: "abs" - must be absolute path, "rel" - must be relative path, "clean" - reject the ".." traversal.
+//
+// Usage:
+//
+//	IsValidPath("conf/app.ini", "rel", "clean") // true
+//	IsValidPath("../etc/passwd", "clean") // false
+func IsValidPath(s string, modes ...string) bool {
+	if s == "" || strings.IndexByte(s, 0) >= 0 {
+		return false
+	}
+
+	for _, mode := range modes {
+		switch strings.TrimSpace(mode) {
+		case "abs":
+			if !filepath.IsAbs(s) {
+				return false
+			}
+		case "rel":
+			if filepath.IsAbs(s) {
+				return false
+			}
+		case "clean":
+			for _, part := range strings.FieldsFunc(s, isPathSeparator) {
+				if part == ".." {
+					return false
+				}
+			}
+		default:
+			panicf("invalid path mode '%s', allow: abs, rel, clean", mode)
+		}
+	}
+	return true
+}
+
+func isPathSeparator(r rune) bool {
+	return r == '/' || r == filepath.Separator
+}
+
 /*************************************************************
  * global: compare validators
  *************************************************************/
@@ -1091,6 +2446,95 @@ func Between(val any, min, max int64) bool {
 	return intVal >= min && intVal <= max
 }
 
+// multipleOf float check epsilon
+const multipleEpsilon = 1e-9
+
+// MultipleOf check the number value is a multiple of the given value.
+// will use the near-divisibility check on the value is float.
+//
+// Usage:
+//
+//	MultipleOf(12, 6) // true
+//	MultipleOf("0.3", 0.1) // true
+func MultipleOf(val, multiple any) bool {
+	if val == nil {
+		return false
+	}
+
+	fVal, err := mathutil.Float(indirectValue(val))
+	if err != nil {
+		return false
+	}
+
+	fMul, err := mathutil.Float(multiple)
+	if err != nil || fMul == 0 {
+		return false
+	}
+
+	// all is integer value
+	if fVal == math.Trunc(fVal) && fMul == math.Trunc(fMul) {
+		if math.Abs(fVal) < math.MaxInt64 && math.Abs(fMul) < math.MaxInt64 {
+			return int64(fVal)%int64(fMul) == 0
+		}
+		return bigMultipleOf(fVal, fMul)
+	}
+
+	rem := math.Abs(math.Mod(fVal, fMul))
+	return rem < multipleEpsilon || math.Abs(fMul)-rem < multipleEpsilon
+}
+
+// check the whole numbers out of the int64 range. the float of a large number
+// is not exact, so use the shortest decimal of the floats. eg: 1e30
+func bigMultipleOf(fVal, fMul float64) bool {
+	rVal, ok := new(big.Rat).SetString(strconv.FormatFloat(fVal, 'g', -1, 64))
+	if !ok {
+		return false
+	}
+
+	rMul, ok := new(big.Rat).SetString(strconv.FormatFloat(fMul, 'g', -1, 64))
+	if !ok {
+		return false
+	}
+	return rVal.Quo(rVal, rMul).IsInt()
+}
+
+// get the float value for check the number sign. NaN is always invalid.
+func signFloat(val any) (float64, bool) {
+	if val == nil {
+		return 0, false
+	}
+
+	fVal, err := mathutil.Float(indirectValue(val))
+	if err != nil || math.IsNaN(fVal) {
+		return 0, false
+	}
+	return fVal, true
+}
+
+// Positive check the value is a number and greater than 0.
+func Positive(val any) bool {
+	fVal, ok := signFloat(val)
+	return ok && fVal > 0
+}
+
+// Negative check the value is a number and less than 0.
+func Negative(val any) bool {
+	fVal, ok := signFloat(val)
+	return ok && fVal < 0
+}
+
+// NonNegative check the value is a number and greater than or equal to 0.
+func NonNegative(val any) bool {
+	fVal, ok := signFloat(val)
+	return ok && fVal >= 0
+}
+
+// NonPositive check the value is a number and less than or equal to 0.
+func NonPositive(val any) bool {
+	fVal, ok := signFloat(val)
+	return ok && fVal <= 0
+}
+
 /*************************************************************
  * global: array, slice, map validators
  *************************************************************/
@@ -1135,6 +2579,254 @@ func NotIn(val, enum any) bool {
 	return !Enum(val, enum)
 }
 
+// InNumbers check the value is a number and is numerically equal to one of the given numbers.
+// the value will be coerced to number. eg: "2" matches 2
+//
+// Usage:
+//
+//	InNumbers("20", 10, 20, 50) // true
+func InNumbers(val any, nums ...float64) bool {
+	if val == nil || len(nums) == 0 {
+		return false
+	}
+
+	fVal, err := mathutil.Float(indirectValue(val))
+	if err != nil {
+		return false
+	}
+
+	for _, num := range nums {
+		if fVal == num {
+			return true
+		}
+	}
+	return false
+}
+
+// registered named value sets. see RegisterSet()
+var (
+	valueSetsMu sync.RWMutex
+	valueSets   = make(map[string][]string)
+)
+
+// RegisterSet register a named value set, can use it on validator by "@name".
+//
+// Usage:
+//
+//	validate.RegisterSet("colors", []string{"red", "green", "blue"})
+//	v.StringRule("color", "index_in:@colors")
+func RegisterSet(name string, values []string) {
+	name = strings.TrimPrefix(name, "@")
+	if name == "" {
+		panicf("RegisterSet: the set name cannot be empty")
+	}
+	valueSetsMu.Lock()
+	valueSets[name] = values
+	valueSetsMu.Unlock()
+}
+
+// get the registered value set by name
+func getValueSet(name string) (values []string, ok bool) {
+	valueSetsMu.RLock()
+	values, ok = valueSets[strings.TrimPrefix(name, "@")]
+	valueSetsMu.RUnlock()
+	return
+}
+
+// IndexIn check the value is a valid index of the named value set. eg: 0 <= val < len(set)
+//
+// Usage:
+//
+//	validate.RegisterSet("colors", []string{"red", "green", "blue"})
+//	IndexIn(1, "@colors") // true
+//	IndexIn("3", "@colors") // false
+func IndexIn(val any, set string) bool {
+	values, ok := getValueSet(set)
+	if !ok {
+		return false
+	}
+
+	idx, err := mathutil.Int(indirectValue(val))
+	if err != nil {
+		return false
+	}
+	return idx >= 0 && idx < len(values)
+}
+
+// registered membership checkers. see AddMembership()
+var (
+	membershipsMu sync.RWMutex
+	memberships   = make(map[string]func(val any) bool)
+)
+
+// AddMembership register a named membership checker, can use it on the
+// validator "member:name" and "not_member:name". Useful for the very
+// large set, eg: backed by a bloom filter or database.
+//
+// Usage:
+//
+//	validate.AddMembership("denylist", func(val any) bool {
+//		return bloom.Test([]byte(val.(string)))
+//	})
+//	v.StringRule("email", "not_member:denylist")
+func AddMembership(name string, fn func(val any) bool) {
+	name = strings.TrimPrefix(name, "@")
+	if name == "" || fn == nil {
+		panicf("AddMembership: the name and checker func cannot be empty")
+	}
+	membershipsMu.Lock()
+	memberships[name] = fn
+	membershipsMu.Unlock()
+}
+
+// get the registered membership checker by name
+func getMembership(name string) (fn func(val any) bool, ok bool) {
+	membershipsMu.RLock()
+	fn, ok = memberships[strings.TrimPrefix(name, "@")]
+	membershipsMu.RUnlock()
+	return
+}
+
+// Member check the value is member of the named membership. see AddMembership()
+func Member(val any, name string) bool {
+	fn, ok := getMembership(name)
+	return ok && fn(val)
+}
+
+// NotMember check the value is not member of the named membership.
+// will return false on the membership is not registered.
+func NotMember(val any, name string) bool {
+	fn, ok := getMembership(name)
+	return ok && !fn(val)
+}
+
+// the default blocklist set name for NotCommon()
+const commonPasswordsSet = "commonPasswords"
+
+// NotCommon check the value is not in the blocklist set(case-insensitive).
+// the set is registered by RegisterSet(), default set name is "commonPasswords".
+//
+// Usage:
+//
+//	validate.RegisterSet("commonPasswords", []string{"123456", "password1!"})
+//	v.StringRule("password", "notCommon")
+//	// use custom set
+//	v.StringRule("password", "notCommon:@myBlocklist")
+func NotCommon(val string, set ...string) bool {
+	name := commonPasswordsSet
+	if len(set) > 0 {
+		name = set[0]
+	}
+
+	values, _ := getValueSet(name)
+	for _, item := range values {
+		if strings.EqualFold(val, item) {
+			return false
+		}
+	}
+	return true
+}
+
+// Entropy calc the Shannon entropy bits of the string. eg: "aaaa" is 0, "abcd" is 8
+func Entropy(s string) float64 {
+	if s == "" {
+		return 0
+	}
+
+	counts := make(map[rune]int)
+	for _, r := range s {
+		counts[r]++
+	}
+
+	var perChar float64
+	total := float64(utf8.RuneCountInString(s))
+	for _, n := range counts {
+		p := float64(n) / total
+		perChar -= p * math.Log2(p)
+	}
+	return perChar * total
+}
+
+// MinEntropy check the Shannon entropy bits of the string is greater or equal the min bits.
+//
+// Usage:
+//
+//	v.StringRule("password", "entropy:40")
+func MinEntropy(val string, minBits float64) bool {
+	return Entropy(val) >= minBits
+}
+
+// IsSorted check the array, slice elements is sorted. order allow: asc(default), desc
+//
+// Usage:
+//
+//	v.AddRule("ids", "sorted")
+//	v.AddRule("ids", "sorted", "desc")
+func IsSorted(val any, order ...string) bool {
+	return SortedIndex(val, order...) == -1
+}
+
+// SortedIndex returns the index of the first element that is out of order
+// in the array, slice. returns -1 if all elements is sorted.
+//
+// only check for elements: int(X), uint(X), float(X), string.
+// if val is not an array, slice or element cannot compare, will return 0.
+func SortedIndex(val any, order ...string) int {
+	rv := reflect.Indirect(reflect.ValueOf(val))
+	if rv.Kind() != reflect.Array && rv.Kind() != reflect.Slice {
+		return 0
+	}
+
+	op := "<="
+	if len(order) > 0 && strings.ToLower(order[0]) == "desc" {
+		op = ">="
+	}
+
+	for i := 1; i < rv.Len(); i++ {
+		prev := indirectInterface(rv.Index(i - 1))
+		cur := indirectInterface(rv.Index(i))
+		if !prev.IsValid() || !cur.IsValid() {
+			return i
+		}
+
+		if !compareSortable(prev, cur, op) {
+			return i
+		}
+	}
+	return -1
+}
+
+// compare two reflect value for sorted check.
+func compareSortable(v1, v2 reflect.Value, op string) bool {
+	k1, err := basicKindV2(v1.Kind())
+	if err != nil {
+		return false
+	}
+
+	k2, err := basicKindV2(v2.Kind())
+	if err != nil {
+		return false
+	}
+
+	if k1 == stringKind && k2 == stringKind {
+		if op == "<=" {
+			return v1.String() <= v2.String()
+		}
+		return v1.String() >= v2.String()
+	}
+
+	f1, err := mathutil.Float(v1.Interface())
+	if err != nil || k1 == stringKind || k1 == boolKind {
+		return false
+	}
+
+	f2, err := mathutil.Float(v2.Interface())
+	if err != nil || k2 == stringKind || k2 == boolKind {
+		return false
+	}
+	return mathutil.CompFloat(f1, f2, op)
+}
+
 /*************************************************************
  * global: length validators
  *************************************************************/
@@ -1170,6 +2862,16 @@ func ByteLength(str string, minLen int, maxLen ...int) bool {
 	return strLen >= minLen && strLen <= maxLen[0]
 }
 
+// BytesMax check the string byte length is at most the max. useful for the database column limit.
+// unlike the MaxLength, the multibyte char is counted by its bytes.
+//
+// Usage:
+//
+//	BytesMax("héllo", 5) // false, it is 6 bytes
+func BytesMax(s string, max int) bool {
+	return len(s) <= max
+}
+
 // RuneLength check string's length (including multibyte strings)
 func RuneLength(val any, minLen int, maxLen ...int) bool {
 	str, isString := val.(string)
@@ -1210,6 +2912,185 @@ func DateFormat(s string, layout string) bool {
 	return err == nil
 }
 
+// the named time layouts, can be used on the DateTime. eg: "datetime:RFC3339"
+var namedTimeLayouts = map[string]string{
+	"ANSIC":       time.ANSIC,
+	"RFC822":      time.RFC822,
+	"RFC1123":     time.RFC1123,
+	"RFC3339":     time.RFC3339,
+	"RFC3339Nano": time.RFC3339Nano,
+	"Kitchen":     time.Kitchen,
+	"DateTime":    "2006-01-02 15:04:05",
+	"DateOnly":    "2006-01-02",
+	"TimeOnly":    "15:04:05",
+}
+
+// DateTime check the string can be parsed by any one of the layouts.
+// multi layouts can be separated by ";", the named layouts is allowed. default layout is time.RFC3339
+//
+// Usage:
+//
+//	DateTime("2024-05-01", "2006-01-02;RFC3339") // true
+//	v.StringRule("startAt", "datetime:2006-01-02;RFC3339")
+func DateTime(s string, layouts ...string) bool {
+	_, _, ok := parseDateTime(s, layouts)
+	return ok
+}
+
+// parse the string by the layouts in order, returns the parsed time and the matched layout.
+func parseDateTime(s string, layouts []string) (time.Time, string, bool) {
+	if len(layouts) == 0 {
+		layouts = []string{time.RFC3339}
+	}
+
+	for _, group := range layouts {
+		for _, layout := range stringSplit(group, ";") {
+			if named, ok := namedTimeLayouts[layout]; ok {
+				layout = named
+			}
+			if t, err := time.Parse(layout, s); err == nil {
+				return t, layout, true
+			}
+		}
+	}
+	return time.Time{}, "", false
+}
+
+// cron expression field bounds and names.
+type cronField struct {
+	min, max int
+	names    map[string]int
+}
+
+var (
+	cronMonthNames = map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}
+	cronDowNames = map[string]int{"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6}
+	// fields: minute, hour, day of month, month, day of week
+	cronFields = []cronField{{0, 59, nil}, {0, 23, nil}, {1, 31, nil}, {1, 12, cronMonthNames}, {0, 7, cronDowNames}}
+	cronSecond = cronField{0, 59, nil}
+	// the predefined schedules
+	cronMacros = []string{"@yearly", "@annually", "@monthly", "@weekly", "@daily", "@midnight", "@hourly"}
+)
+
+// IsCron check the string is a valid cron expression. allow the standard 5 fields,
+// the optional 6 fields with the seconds at first, and the macros. eg: "@daily"
+//
+// Usage:
+//
+//	IsCron("*/5 * * * *") // true
+//	IsCron("0 */5 * * * *") // true, with seconds
+//	IsCron("60 * * * *") // false
+func IsCron(s string) bool {
+	fields := strings.Fields(s)
+	if len(fields) == 1 {
+		return arrutil.StringsHas(cronMacros, strings.ToLower(fields[0]))
+	}
+
+	specs := cronFields
+	switch len(fields) {
+	case 5:
+	case 6:
+		specs = append([]cronField{cronSecond}, cronFields...)
+	default:
+		return false
+	}
+
+	for i, field := range fields {
+		if !specs[i].check(field) {
+			return false
+		}
+	}
+	return true
+}
+
+// check the field, format: item[,item...], item allow: "*", "?", "N", "N-M", with optional "/step"
+func (f cronField) check(field string) bool {
+	for _, item := range strings.Split(field, ",") {
+		rangeStr, stepStr, hasStep := strings.Cut(item, "/")
+		if hasStep {
+			if step, err := strconv.Atoi(stepStr); err != nil || step <= 0 || step > f.max {
+				return false
+			}
+		}
+
+		if rangeStr == "*" || rangeStr == "?" {
+			continue
+		}
+
+		start, end, isRange := strings.Cut(rangeStr, "-")
+		minVal, ok := f.value(start)
+		if !ok {
+			return false
+		}
+
+		if isRange {
+			maxVal, ok := f.value(end)
+			if !ok || maxVal < minVal {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (f cronField) value(s string) (int, bool) {
+	if n, ok := f.names[strings.ToUpper(s)]; ok {
+		return n, true
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil || n < f.min || n > f.max {
+		return 0, false
+	}
+	return n, true
+}
+
+// the OpenAPI format checkers, map the format name to the validator. see IsFormat
+var openAPIFormats = map[string]func(val any) bool{
+	"date-time":     stringChecker(func(s string) bool { return DateFormat(s, time.RFC3339) }),
+	"date":          stringChecker(func(s string) bool { return DateFormat(s, "2006-01-02") }),
+	"time":          stringChecker(func(s string) bool { return DateFormat(s, "15:04:05Z07:00") }),
+	"email":         stringChecker(IsEmail),
+	"hostname":      stringChecker(IsDNSName),
+	"ipv4":          stringChecker(IsIPv4),
+	"ipv6":          stringChecker(IsIPv6),
+	"uri":           stringChecker(IsFullURL),
+	"uri-reference": stringChecker(IsURL),
+	"uuid":          stringChecker(IsUUID),
+	"byte":          stringChecker(IsBase64),
+	"regex":         stringChecker(IsRegex),
+	"json-pointer":  stringChecker(IsJSONPointer),
+	"int32":         IsInt32,
+	"int64":         IsInt64,
+	"float":         IsFloat32,
+	"double":        IsFloat64,
+}
+
+func stringChecker(fn func(s string) bool) func(val any) bool {
+	return func(val any) bool {
+		s, ok := val.(string)
+		return ok && fn(s)
+	}
+}
+
+// IsFormat check the value by the OpenAPI format name. eg: "date-time", "email", "uuid"
+// the unknown format is always passed, on validate will add a warning.
+//
+// Usage:
+//
+//	IsFormat("2024-05-01T10:00:00Z", "date-time") // true
+//	v.StringRule("createdAt", "format:date-time")
+func IsFormat(val any, format string) bool {
+	fn, ok := openAPIFormats[format]
+	if !ok {
+		return true
+	}
+	return fn(indirectValue(val))
+}
+
 // DateEquals check.
 // Usage:
 // 	DateEquals(val, "2017-05-12")
@@ -91,7 +91,7 @@ func buildRule(fields, validator, realName string, args []any) *Rule {
 	rule.realName = realName
 	rule.skipEmpty = gOpt.SkipOnEmpty
 	// validator name is not "required"
-	rule.nameNotRequired = !strings.HasPrefix(realName, "required")
+	rule.nameNotRequired = !isPresenceValidator(realName)
 
 	return rule
 }